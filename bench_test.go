@@ -0,0 +1,50 @@
+package ggda
+
+import (
+	"testing"
+)
+
+type benchItem struct {
+	ID    int
+	Name  string
+	Score float64
+	Admin bool
+}
+
+const benchCount = 1000
+
+func BenchmarkGenerate(b *testing.B) {
+	g := New[benchItem]()
+	for b.Loop() {
+		g.Generate(benchCount)
+	}
+}
+
+func BenchmarkGenerateFastPath(b *testing.B) {
+	g := New[benchItem]().SetFastPath(func(v *benchItem, index int) {
+		v.ID = index + 1
+		v.Name = "name"
+		v.Score = float64(index) * 1.1
+		v.Admin = index%2 == 0
+	})
+	for b.Loop() {
+		g.Generate(benchCount)
+	}
+}
+
+type benchTagged struct {
+	ID      int     `ggda:"min=1,max=1000"`
+	Name    string  `ggda:"len=8"`
+	Email   string  `ggda:"faker=email"`
+	Score   float64 `ggda:"range=0,100"`
+	Status  string  `ggda:"oneof=active,inactive"`
+	Skipped string  `ggda:"-"`
+	Note    string
+}
+
+func BenchmarkGenerateTagged(b *testing.B) {
+	g := New[benchTagged]()
+	for b.Loop() {
+		g.Generate(benchCount)
+	}
+}
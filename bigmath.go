@@ -0,0 +1,52 @@
+package ggda
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+)
+
+// fillBig sets big.Int and big.Float fields, reporting whether field was one of them
+// Pointers such as *big.Int reach here through the pointer case of autoFill
+// The bits option gives big.Int values exactly that bit length and sets the precision of big.Float values
+func (g *Generator[T]) fillBig(field reflect.Value, opts tagOptions, path string, index int) (bool, error) {
+	if field.Type() != bigIntType && field.Type() != bigFloatType {
+		return false, nil
+	}
+	bits, hasBits, err := opts.intOption(path, "bits")
+	if err != nil {
+		return true, err
+	}
+	if hasBits && bits <= 0 {
+		return true, fmt.Errorf("ggda: field %s: bits must be positive, got %d", path, bits)
+	}
+	if field.Type() == bigFloatType {
+		f := field.Addr().Interface().(*big.Float)
+		if hasBits {
+			f.SetPrec(uint(bits))
+		}
+		f.SetFloat64(g.floatValue(index))
+		return true, nil
+	}
+
+	n := field.Addr().Interface().(*big.Int)
+	if !hasBits {
+		n.SetInt64(g.intValue(index, 64))
+		return true, nil
+	}
+	// the top bit is always set so every value has the requested length
+	top := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	if g.rand != nil {
+		n.Rand(g.rand, top)
+	} else {
+		n.SetInt64(int64(index + 1))
+		n.Mod(n, top)
+	}
+	n.Add(n, top)
+	return true, nil
+}
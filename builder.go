@@ -22,8 +22,77 @@ func (b *Builder[T]) With(modifier func(v *T, index int)) *Builder[T] {
 	return b
 }
 
+// WithIf sets values using a modifier function that only runs when pred returns true for the index
+func (b *Builder[T]) WithIf(pred func(index int) bool, modifier func(v *T, index int)) *Builder[T] {
+	return b.With(func(v *T, index int) {
+		if pred(index) {
+			modifier(v, index)
+		}
+	})
+}
+
+// WithEvery sets values using a modifier function that runs for every nth index, starting at 0
+// n <= 0 never runs the modifier
+func (b *Builder[T]) WithEvery(n int, modifier func(v *T, index int)) *Builder[T] {
+	return b.WithIf(func(index int) bool {
+		return n > 0 && index%n == 0
+	}, modifier)
+}
+
 // WithDefaults sets default values using a struct
+// Zero-valued fields are ignored; use WithDefaultsForce to keep them
 func (b *Builder[T]) WithDefaults(defaults T) *Builder[T] {
+	b.setDefaults(defaults, false)
+	return b
+}
+
+// WithDefaultsForce sets every exported field of defaults as a default value, including zero values
+func (b *Builder[T]) WithDefaultsForce(defaults T) *Builder[T] {
+	b.setDefaults(defaults, true)
+	return b
+}
+
+// WithDefaultsFunc sets per-index default values using a struct returned by fn
+// Non-zero fields of the result are kept while the rest are filled as usual,
+// so they take precedence over WithDefaults and customs
+func (b *Builder[T]) WithDefaultsFunc(fn func(index int) T) *Builder[T] {
+	b.gen.BeforeEach(func(v *T, index int) {
+		copyNonZero(reflect.ValueOf(v).Elem(), reflect.ValueOf(fn(index)))
+	})
+	return b
+}
+
+// copyNonZero copies the non-zero exported fields of src into dst
+func copyNonZero(dst, src reflect.Value) {
+	t := src.Type()
+	for i := 0; i < src.NumField(); i++ {
+		if t.Field(i).IsExported() && !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
+
+// WithNested generates the struct field fieldName of type U or *U with sub at the same index
+// It is a function rather than a method because methods cannot have type parameters
+// It panics during generation if sub fails
+func WithNested[T, U any](b *Builder[T], fieldName string, sub *Builder[U]) *Builder[T] {
+	field, err := resolvePath(reflect.TypeOf((*T)(nil)).Elem(), fieldName)
+	pointer := err == nil && field.Type == reflect.TypeOf((*U)(nil))
+	b.gen.SetCustom(fieldName, func(index int) interface{} {
+		v, err := sub.generateSingle(index)
+		if err != nil {
+			panic(err)
+		}
+		if pointer {
+			return &v
+		}
+		return v
+	})
+	return b
+}
+
+// setDefaults copies the exported fields of defaults into the generator
+func (b *Builder[T]) setDefaults(defaults T, includeZero bool) {
 	v := reflect.ValueOf(defaults)
 	t := v.Type()
 
@@ -31,40 +100,81 @@ func (b *Builder[T]) WithDefaults(defaults T) *Builder[T] {
 		field := v.Field(i)
 		fieldType := t.Field(i)
 
-		if !field.IsZero() {
-			b.gen.defaults[fieldType.Name] = field.Interface()
+		if fieldType.IsExported() && (includeZero || !field.IsZero()) {
+			b.gen.SetDefaults(fieldType.Name, field.Interface())
 		}
 	}
-
-	return b
 }
 
 // Generate creates a slice of structs
 func (b *Builder[T]) Generate(count int) []T {
+	result, err := b.GenerateE(count)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// GenerateE creates a slice of structs, returning an error instead of panicking
+func (b *Builder[T]) GenerateE(count int) ([]T, error) {
+	if err := b.gen.validatePaths(); err != nil {
+		return nil, err
+	}
 	result := make([]T, count)
 	for i := 0; i < count; i++ {
-		result[i] = b.generateSingle(i)
+		elem, err := b.generateSingle(i)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = elem
 	}
-	return result
+	return result, nil
 }
 
 // GenerateOne creates a single struct
 func (b *Builder[T]) GenerateOne() T {
-	return b.generateSingle(0)
+	if err := b.gen.validatePaths(); err != nil {
+		panic(err)
+	}
+	elem, err := b.generateSingle(0)
+	if err != nil {
+		panic(err)
+	}
+	return elem
 }
 
-// generateSingle generates a single struct at the given index
-func (b *Builder[T]) generateSingle(index int) T {
-	var elem T
+// Times creates n structs; it reads better than Generate at the end of a chain
+// like Build[User]().With(admin).Times(5)
+func (b *Builder[T]) Times(n int) []T {
+	return b.Generate(n)
+}
+
+// First creates the struct at index 0, the same one Times would return first
+func (b *Builder[T]) First() T {
+	return b.GenerateOne()
+}
 
+// Generator returns a generator configured like the builder
+// Modifiers run after each struct is filled, as they do in Generate
+// The returned generator is a copy, so later builder calls do not affect it
+func (b *Builder[T]) Generator() *Generator[T] {
+	gen := b.gen.Clone()
+	gen.afterEach = append(gen.afterEach, b.modifiers...)
+	return gen
+}
+
+// generateSingle generates a single struct at the given index
+func (b *Builder[T]) generateSingle(index int) (T, error) {
 	// fill struct with defaults and auto-generation
-	v := reflect.ValueOf(&elem).Elem()
-	b.gen.fillStruct(v, index)
+	elem, err := b.gen.generateSingle(index)
+	if err != nil {
+		return elem, err
+	}
 
 	// apply modifiers
 	for _, modifier := range b.modifiers {
 		modifier(&elem, index)
 	}
 
-	return elem
+	return elem, nil
 }
@@ -0,0 +1,51 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// defaultBytesLen is the length of generated []byte values
+const defaultBytesLen = 16
+
+// isBytes reports whether t is a byte slice
+func isBytes(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
+
+// bytesValue returns the value for a []byte field
+// The length comes from the bytes option, then SetSliceLen, then defaultBytesLen
+func (g *Generator[T]) bytesValue(opts tagOptions, path string, index int) ([]byte, error) {
+	n := defaultBytesLen
+	if l, ok := g.sliceLens[path]; ok {
+		n = l
+	}
+	l, ok, err := opts.intOption(path, "bytes")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if l < 0 {
+			return nil, fmt.Errorf("ggda: field %s: negative bytes %d", path, l)
+		}
+		n = int(l)
+	}
+	b := make([]byte, n)
+	if g.rand != nil {
+		g.rand.Read(b)
+		return b, nil
+	}
+	fillBytes(b, index)
+	return b, nil
+}
+
+// fillBytes fills b with bytes that depend only on index
+func fillBytes(b []byte, index int) {
+	x := mix64(uint64(index))
+	for j := range b {
+		if j > 0 && j%8 == 0 {
+			x = mix64(x)
+		}
+		b[j] = byte(x >> (8 * (j % 8)))
+	}
+}
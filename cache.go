@@ -0,0 +1,48 @@
+package ggda
+
+import (
+	"reflect"
+	"sync"
+)
+
+// structField holds the parts of a struct field that fillStruct needs on every fill
+type structField struct {
+	reflect.StructField
+	ignored       bool // tagged `ggda:"-"`
+	protoInternal bool
+}
+
+// fieldCache holds the fields of struct types, keyed by reflect.Type
+var fieldCache sync.Map
+
+// tagCache holds parsed ggda tags, keyed by the raw tag
+var tagCache sync.Map
+
+// cachedFields returns the fields of the struct type t, computed once per type
+func cachedFields(t reflect.Type) []structField {
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.([]structField)
+	}
+	fields := make([]structField, t.NumField())
+	for i := range fields {
+		f := t.Field(i)
+		fields[i] = structField{
+			StructField:   f,
+			ignored:       f.Tag.Get(tagName) == "-",
+			protoInternal: isProtoInternal(f),
+		}
+	}
+	cached, _ := fieldCache.LoadOrStore(t, fields)
+	return cached.([]structField)
+}
+
+// cachedTag returns the parsed ggda tag of f
+// The options are shared and must not be modified
+func cachedTag(f reflect.StructField) tagOptions {
+	tag := f.Tag.Get(tagName)
+	if opts, ok := tagCache.Load(tag); ok {
+		return opts.(tagOptions)
+	}
+	opts, _ := tagCache.LoadOrStore(tag, parseTag(tag))
+	return opts.(tagOptions)
+}
@@ -0,0 +1,184 @@
+package ggda
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"sync"
+)
+
+// Clone returns a copy of the generator that can be configured independently
+// A seeded clone restarts its random sequence from the seed, and LastIndex starts over
+func (g *Generator[T]) Clone() *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.cloneLocked()
+}
+
+// cloneLocked is Clone with the lock held
+func (g *Generator[T]) cloneLocked() *Generator[T] {
+	c := *g
+	c.mu = &sync.Mutex{}
+	c.nextIndex = 0
+	c.populated = nil
+	c.defaults = maps.Clone(g.defaults)
+	c.customs = maps.Clone(g.customs)
+	c.ctxCustoms = maps.Clone(g.ctxCustoms)
+	c.sliceLens = maps.Clone(g.sliceLens)
+	c.mapLens = maps.Clone(g.mapLens)
+	c.sqlExclude = maps.Clone(g.sqlExclude)
+	c.onlyFields = maps.Clone(g.onlyFields)
+	c.excludeFields = maps.Clone(g.excludeFields)
+	c.ifaceImpls = maps.Clone(g.ifaceImpls)
+	c.boolRates = maps.Clone(g.boolRates)
+	c.templates = maps.Clone(g.templates)
+	c.dictionaries = maps.Clone(g.dictionaries)
+	c.typeDefaults = maps.Clone(g.typeDefaults)
+	c.enums = maps.Clone(g.enums)
+	c.foreignKeys = maps.Clone(g.foreignKeys)
+	c.nilRates = maps.Clone(g.nilRates)
+	c.sequences = maps.Clone(g.sequences)
+	c.kindCustoms = maps.Clone(g.kindCustoms)
+	c.predicateCustoms = slices.Clone(g.predicateCustoms)
+	c.beforeEach = slices.Clone(g.beforeEach)
+	c.afterEach = slices.Clone(g.afterEach)
+	c.dependents = slices.Clone(g.dependents)
+	c.invariants = slices.Clone(g.invariants)
+	c.mutexGroups = slices.Clone(g.mutexGroups)
+	c.timeOrders = slices.Clone(g.timeOrders)
+	if g.rand != nil {
+		c.rand = rand.New(rand.NewSource(g.seed))
+	}
+	return &c
+}
+
+// Merge copies the per-field configuration of other into g
+// Entries already set on g take precedence over those from other
+func (g *Generator[T]) Merge(other *Generator[T]) *Generator[T] {
+	if other == g {
+		return g
+	}
+	// snapshot other first so the two locks are never held together
+	o := other.Clone()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	mergeMissing(g.defaults, o.defaults)
+	for path, fn := range o.customs {
+		if !g.hasCustom(path) {
+			g.customs[path] = fn
+		}
+	}
+	for path, fn := range o.ctxCustoms {
+		if !g.hasCustom(path) {
+			g.ctxCustoms[path] = fn
+		}
+	}
+	mergeMissing(g.sliceLens, o.sliceLens)
+	mergeMissing(g.mapLens, o.mapLens)
+	mergeMissing(g.sqlExclude, o.sqlExclude)
+	mergeMissing(g.ifaceImpls, o.ifaceImpls)
+	mergeMissing(g.boolRates, o.boolRates)
+	mergeMissing(g.templates, o.templates)
+	mergeMissing(g.dictionaries, o.dictionaries)
+	mergeMissing(g.typeDefaults, o.typeDefaults)
+	mergeMissing(g.enums, o.enums)
+	mergeMissing(g.foreignKeys, o.foreignKeys)
+	mergeMissing(g.nilRates, o.nilRates)
+	mergeMissing(g.sequences, o.sequences)
+	mergeMissing(g.kindCustoms, o.kindCustoms)
+	g.predicateCustoms = append(g.predicateCustoms, o.predicateCustoms...)
+	for _, d := range o.dependents {
+		if !slices.ContainsFunc(g.dependents, func(own dependent[T]) bool { return own.field == d.field }) {
+			g.dependents = append(g.dependents, d)
+		}
+	}
+	for _, group := range o.mutexGroups {
+		// a field belongs to one group, so g's grouping of it wins
+		if !slices.ContainsFunc(group, g.inMutexGroup) {
+			g.mutexGroups = append(g.mutexGroups, group)
+		}
+	}
+	for _, order := range o.timeOrders {
+		// each later field is set by one order
+		if !slices.ContainsFunc(g.timeOrders, func(t timeOrder) bool { return t.later == order.later }) {
+			g.timeOrders = append(g.timeOrders, order)
+		}
+	}
+	return g
+}
+
+// hasCustom reports whether the field at path has a custom of either kind
+// SetCustom and SetCustomCtx replace each other, so Merge treats them as one setting
+func (g *Generator[T]) hasCustom(path string) bool {
+	_, ok := g.customs[path]
+	_, okCtx := g.ctxCustoms[path]
+	return ok || okCtx
+}
+
+// mergeMissing copies the entries of src whose keys are not in dst
+func mergeMissing[K comparable, V any](dst, src map[K]V) {
+	for k, v := range src {
+		if _, ok := dst[k]; !ok {
+			dst[k] = v
+		}
+	}
+}
+
+// Reset clears all customs, defaults and other configuration, including the seed
+// The generator behaves as if it was just created with New
+func (g *Generator[T]) Reset() *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	clear(g.defaults)
+	clear(g.customs)
+	clear(g.ctxCustoms)
+	clear(g.sliceLens)
+	clear(g.mapLens)
+	clear(g.sqlExclude)
+	clear(g.onlyFields)
+	clear(g.excludeFields)
+	clear(g.ifaceImpls)
+	clear(g.boolRates)
+	clear(g.templates)
+	clear(g.dictionaries)
+	clear(g.typeDefaults)
+	clear(g.enums)
+	clear(g.foreignKeys)
+	clear(g.nilRates)
+	clear(g.sequences)
+	clear(g.kindCustoms)
+	g.maxDepth = defaultMaxDepth
+	g.fillPointers = true
+	g.rand = nil
+	g.seed = 0
+	g.chanBuffer = defaultChanBuffer
+	g.startIndex = 0
+	g.nextIndex = 0
+	g.timeStrategy = nil
+	g.timeLocation = nil
+	g.edgeCases = false
+	g.stringProvider = nil
+	g.locale = defaultLocale
+	g.strict = false
+	g.randomUUIDs = false
+	g.fieldLimit = noFieldLimit
+	g.stubFuncs = false
+	g.protoSafe = false
+	g.triStateBools = false
+	g.respectValidate = false
+	g.intStrategy = nil
+	g.uintStrategy = nil
+	g.floatStrategy = nil
+	g.beforeEach = nil
+	g.afterEach = nil
+	g.dependents = nil
+	g.predicateCustoms = nil
+	g.fastPath = nil
+	g.invariants = nil
+	g.mutexGroups = nil
+	g.timeOrders = nil
+	g.invariantRetries = 0
+	return g
+}
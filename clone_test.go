@@ -0,0 +1,69 @@
+package ggda
+
+import (
+	"testing"
+	"time"
+)
+
+type mergeItem struct {
+	A, B string
+}
+
+func TestMergeCustomPrecedence(t *testing.T) {
+	g := New[mergeItem]().SetCustomCtx("B", func(FieldContext) interface{} { return "receiver" })
+	other := New[mergeItem]().SetCustom("B", func(int) interface{} { return "other" }).
+		SetCustom("A", func(int) interface{} { return "other" })
+
+	v := g.Merge(other).GenerateOne()
+	if v.B != "receiver" {
+		t.Errorf("B = %q; want the receiver's custom", v.B)
+	}
+	if v.A != "other" {
+		t.Errorf("A = %q; want the merged custom", v.A)
+	}
+}
+
+func TestMergeTimeOrders(t *testing.T) {
+	type item struct {
+		C, U, V time.Time
+	}
+	g := New[item]().SetTimeOrder("C", "V", 0)
+	other := New[item]().SetTimeOrder("C", "U", time.Minute).SetTimeOrder("U", "V", time.Hour)
+
+	v := g.Merge(other).GenerateOne()
+	if v.U.Before(v.C) || v.U.After(v.C.Add(time.Minute)) {
+		t.Errorf("U = %v; want within a minute after C = %v", v.U, v.C)
+	}
+	if !v.V.Equal(v.C) {
+		t.Errorf("V = %v; want the receiver's order to keep it equal to C = %v", v.V, v.C)
+	}
+}
+
+func TestMergeMutexGroups(t *testing.T) {
+	type item struct {
+		A, B, C string
+	}
+	g := New[item]().SetMutexGroup("A", "B")
+	other := New[item]().SetMutexGroup("B", "C").SetMutexGroup("C")
+
+	for i, v := range g.Merge(other).Generate(4) {
+		if (v.A == "") == (v.B == "") {
+			t.Errorf("item %d: A = %q, B = %q; want exactly one set", i, v.A, v.B)
+		}
+		if v.C == "" {
+			t.Errorf("item %d: C is empty; want the merged single-field group to keep it", i)
+		}
+	}
+}
+
+func TestMergeDependents(t *testing.T) {
+	g := New[mergeItem]().SetDependent("A", func(v *mergeItem, index int) { v.A = "receiver" })
+	other := New[mergeItem]().
+		Derive("A", func(v *mergeItem) interface{} { return "other" }).
+		Derive("B", func(v *mergeItem) interface{} { return v.A + "!" })
+
+	v := g.Merge(other).GenerateOne()
+	if v.A != "receiver" || v.B != "receiver!" {
+		t.Errorf("A, B = %q, %q; want %q, %q", v.A, v.B, "receiver", "receiver!")
+	}
+}
@@ -0,0 +1,103 @@
+package ggda
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+)
+
+// WriteCSV writes a header row of field names and one row per generated struct
+// Fields appear in declaration order and are named after their json tag;
+// unexported fields and fields tagged `json:"-"` are left out
+// time.Time is formatted as RFC3339, nil pointers and omitempty zero values become empty cells,
+// text marshalers and Stringers use their own output, and other structs, slices and maps are JSON encoded into a single cell
+func (g *Generator[T]) WriteCSV(w io.Writer, count int) error {
+	if err := g.validatePaths(); err != nil {
+		return err
+	}
+	var fields []reflect.StructField
+	var header []string
+	var omitEmpty []bool
+	for _, f := range exportedFields(reflect.TypeOf((*T)(nil)).Elem()) {
+		name, omit, ok := externalName(f)
+		if !ok {
+			continue
+		}
+		fields = append(fields, f)
+		header = append(header, name)
+		omitEmpty = append(omitEmpty, omit)
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	row := make([]string, len(fields))
+	for i := 0; i < count; i++ {
+		elem, err := g.generateSingle(i)
+		if err != nil {
+			return err
+		}
+		v := reflect.ValueOf(&elem).Elem()
+		for j, f := range fields {
+			fv := v.FieldByIndex(f.Index)
+			if omitEmpty[j] && fv.IsZero() {
+				row[j] = ""
+				continue
+			}
+			cell, err := formatCell(fv)
+			if err != nil {
+				return fmt.Errorf("ggda: field %s: %w", f.Name, err)
+			}
+			row[j] = cell
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatCell formats a field value as a CSV cell
+// Text marshalers and Stringers are checked on the value and its address before falling back to JSON,
+// so pointer-receiver methods like those of big.Int are found
+func formatCell(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return "", nil
+	}
+	elem := reflect.Indirect(v)
+	if elem.Type() == timeType {
+		return elem.Interface().(time.Time).Format(time.RFC3339), nil
+	}
+	target := v
+	if v.Kind() != reflect.Ptr && v.CanAddr() {
+		target = v.Addr()
+	}
+	switch m := target.Interface().(type) {
+	case encoding.TextMarshaler:
+		text, err := m.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	case fmt.Stringer:
+		return m.String(), nil
+	}
+	switch elem.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map, reflect.Interface:
+		data, err := json.Marshal(target.Interface())
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	return fmt.Sprint(elem.Interface()), nil
+}
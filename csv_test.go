@@ -0,0 +1,28 @@
+package ggda
+
+import (
+	"bytes"
+	"math/big"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+type csvItem struct {
+	Amount *big.Int   `json:"amount"`
+	Addr   netip.Addr `json:"addr"`
+}
+
+func TestWriteCSVUsesTextMarshalers(t *testing.T) {
+	g := New[csvItem]().
+		SetCustom("Amount", func(int) interface{} { return big.NewInt(42) }).
+		SetCustom("Addr", func(int) interface{} { return netip.MustParseAddr("10.0.0.1") })
+	var buf bytes.Buffer
+	if err := g.WriteCSV(&buf, 1); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || lines[1] != "42,10.0.0.1" {
+		t.Errorf("CSV = %q; want row 42,10.0.0.1", buf.String())
+	}
+}
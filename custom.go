@@ -0,0 +1,107 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// predicateCustom generates the fields matched by pred
+type predicateCustom struct {
+	pred func(f reflect.StructField) bool
+	fn   func(index int) interface{}
+}
+
+// FieldContext describes the field a SetCustomCtx function is generating
+type FieldContext struct {
+	// Index is the index of the item being generated
+	Index int
+	// Field is the path of the field, like "Address.Zip"
+	Field string
+
+	root reflect.Value
+}
+
+// Get returns a copy of the field at path in the item being generated, or nil if there is none
+// Fields fill in declaration order, so only earlier fields already hold generated values
+func (c FieldContext) Get(path string) interface{} {
+	if !c.root.IsValid() {
+		return nil
+	}
+	field, err := fieldByPath(c.root, path)
+	if err != nil {
+		return nil
+	}
+	return field.Interface()
+}
+
+// SetCustomCtx is like SetCustom but fn also sees the fields filled so far through ctx
+// It replaces a SetCustom for the same field and is replaced by one
+func (g *Generator[T]) SetCustomCtx(fieldName string, fn func(ctx FieldContext) interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.customs, fieldName)
+	g.ctxCustoms[fieldName] = fn
+	return g
+}
+
+// SetCustomTyped is a type-checked SetCustom
+// It returns an error if fieldName does not exist or F is not assignable to the field,
+// so mismatches surface when configuring instead of during generation
+func SetCustomTyped[T, F any](g *Generator[T], fieldName string, fn func(index int) F) error {
+	field, err := resolvePath(reflect.TypeOf((*T)(nil)).Elem(), fieldName)
+	if err != nil {
+		return err
+	}
+	ft := reflect.TypeOf((*F)(nil)).Elem()
+	if !ft.AssignableTo(field.Type) {
+		return fmt.Errorf("ggda: field %s: cannot assign value of type %s to type %s", fieldName, ft, field.Type)
+	}
+	g.SetCustom(fieldName, func(index int) interface{} {
+		return fn(index)
+	})
+	return nil
+}
+
+// SetCustomByType sets a custom generator for every field of the given kind, like all strings
+// Values are converted to named types of that kind, so a string custom also fills a Status string type
+// Per-field customs and defaults take precedence
+func (g *Generator[T]) SetCustomByType(kind reflect.Kind, fn func(index int) interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.kindCustoms[kind] = fn
+	return g
+}
+
+// SetCustomByPredicate sets a custom generator for every field pred returns true for
+// Predicates are tried in the order they were set, before SetCustomByType
+func (g *Generator[T]) SetCustomByPredicate(pred func(f reflect.StructField) bool, fn func(index int) interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.predicateCustoms = append(g.predicateCustoms, predicateCustom{pred: pred, fn: fn})
+	return g
+}
+
+// matchCustom returns the predicate or kind custom for a field
+func (g *Generator[T]) matchCustom(fieldType reflect.StructField) (func(index int) interface{}, bool) {
+	for _, c := range g.predicateCustoms {
+		var match bool
+		g.unlocked(func() { match = c.pred(fieldType) })
+		if match {
+			return c.fn, true
+		}
+	}
+	fn, ok := g.kindCustoms[fieldType.Type.Kind()]
+	return fn, ok
+}
+
+// setConverted is like setValue but also converts between types of the same kind
+func setConverted(field reflect.Value, fieldName string, value interface{}) error {
+	if value != nil {
+		rv := reflect.ValueOf(value)
+		if rv.Kind() == field.Kind() && !rv.Type().AssignableTo(field.Type()) && rv.Type().ConvertibleTo(field.Type()) {
+			field.Set(rv.Convert(field.Type()))
+			return nil
+		}
+	}
+	return setValue(field, fieldName, value)
+}
@@ -0,0 +1,85 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// dependent computes a field from the rest of the struct
+type dependent[T any] struct {
+	field string
+	fn    func(v *T, index int) error
+}
+
+// SetDependent registers fn to compute fieldName once every field is filled
+// fn receives the whole struct so it can read sibling fields, e.g. a FullName from First and Last
+// Dependents run in the order they were first set, before AfterEach hooks
+func (g *Generator[T]) SetDependent(fieldName string, fn func(v *T, index int)) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.setDependent(fieldName, func(v *T, index int) error {
+		fn(v, index)
+		return nil
+	})
+	return g
+}
+
+// Derive sets targetField to the value fn returns once every field is filled
+// It is the value-returning form of SetDependent, e.g. an Email built from Name,
+// and shares its ordering; setting either for the same field replaces the other
+func (g *Generator[T]) Derive(targetField string, fn func(v *T) interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.setDependent(targetField, func(v *T, index int) error {
+		field, err := fieldByPath(reflect.ValueOf(v).Elem(), targetField)
+		if err != nil {
+			return err
+		}
+		return setValue(field, targetField, fn(v))
+	})
+	return g
+}
+
+// setDependent adds a dependent or replaces the one for the same field, keeping its order
+func (g *Generator[T]) setDependent(fieldName string, fn func(v *T, index int) error) {
+	i := slices.IndexFunc(g.dependents, func(d dependent[T]) bool { return d.field == fieldName })
+	if i >= 0 {
+		g.dependents[i].fn = fn
+		return
+	}
+	g.dependents = append(g.dependents, dependent[T]{field: fieldName, fn: fn})
+}
+
+// applyDependents runs the dependents of elem in order
+func (g *Generator[T]) applyDependents(elem *T, index int) error {
+	for _, d := range g.dependents {
+		var err error
+		g.unlocked(func() { err = d.fn(elem, index) })
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldByPath returns the field of the struct v at a dotted path, following pointers
+func fieldByPath(v reflect.Value, path string) (reflect.Value, error) {
+	for _, name := range strings.Split(path, ".") {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, fmt.Errorf("ggda: field %s: nil pointer before %s", path, name)
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("ggda: field %s: %s is not a struct", path, v.Type())
+		}
+		v = v.FieldByName(name)
+		if !v.IsValid() || !v.CanSet() {
+			return reflect.Value{}, fmt.Errorf("ggda: field %s: no settable field %s", path, name)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,77 @@
+package ggda
+
+import (
+	"math"
+	"reflect"
+	"strings"
+)
+
+const (
+	// edgeCaseInterval is how often an edge case is used without a seed, one in every N indexes
+	edgeCaseInterval = 5
+	// edgeCaseRate is the probability of an edge case when a seed is set
+	edgeCaseRate = 1.0 / edgeCaseInterval
+	// longStringLen is the length of the long string edge case
+	longStringLen = 1024
+)
+
+// EdgeCases sets whether autoFill occasionally uses boundary values such as
+// empty strings, min and max integers, zero, NaN and infinities
+// Edge cases ignore min, max and len tags
+func (g *Generator[T]) EdgeCases(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.edgeCases = enabled
+	return g
+}
+
+// fillEdgeCase sets field to a boundary value when edge cases are enabled and
+// the index is chosen for one, reporting whether it did
+func (g *Generator[T]) fillEdgeCase(field reflect.Value, index int) bool {
+	if !g.edgeCases {
+		return false
+	}
+	if g.rand != nil {
+		if g.rand.Float64() >= edgeCaseRate {
+			return false
+		}
+	} else if index%edgeCaseInterval != edgeCaseInterval-1 {
+		return false
+	}
+
+	values := edgeValues(field.Type())
+	if len(values) == 0 {
+		return false
+	}
+	i := (index / edgeCaseInterval) % len(values)
+	if g.rand != nil {
+		i = g.rand.Intn(len(values))
+	}
+	field.Set(values[i].Convert(field.Type()))
+	return true
+}
+
+// edgeValues returns the boundary values for primitive types
+func edgeValues(t reflect.Type) []reflect.Value {
+	var values []interface{}
+	switch t.Kind() {
+	case reflect.String:
+		values = []interface{}{"", " ", strings.Repeat("x", longStringLen)}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bits := t.Bits()
+		values = []interface{}{int64(0), int64(-1), int64(math.MinInt64 >> (64 - bits)), int64(math.MaxInt64 >> (64 - bits))}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		values = []interface{}{uint64(0), uint64(math.MaxUint64 >> (64 - t.Bits()))}
+	case reflect.Float32:
+		values = []interface{}{0.0, math.NaN(), math.Inf(1), math.Inf(-1), float64(math.MaxFloat32), float64(math.SmallestNonzeroFloat32)}
+	case reflect.Float64:
+		values = []interface{}{0.0, math.NaN(), math.Inf(1), math.Inf(-1), math.MaxFloat64, math.SmallestNonzeroFloat64}
+	case reflect.Bool:
+		values = []interface{}{false}
+	}
+	result := make([]reflect.Value, len(values))
+	for i, v := range values {
+		result[i] = reflect.ValueOf(v)
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package ggda
+
+import (
+	"reflect"
+	"slices"
+)
+
+// RegisterEnum restricts every field of sampleField's type to values, e.g.
+// RegisterEnum(Red, Red, Green, Blue) for a `type Color int` with constants
+// Values of the same kind are converted, so untyped constants work as well;
+// oneof tags and per-field customs still take precedence
+func (g *Generator[T]) RegisterEnum(sampleField interface{}, values ...interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	t := reflect.TypeOf(sampleField)
+	if t == nil {
+		return g
+	}
+	if len(values) == 0 {
+		delete(g.enums, t)
+		return g
+	}
+	g.enums[t] = slices.Clone(values)
+	return g
+}
+
+// fillEnum sets field to one of the values registered for its type
+// Values cycle by index, or are drawn from the seed when one is set
+func (g *Generator[T]) fillEnum(field reflect.Value, path string, index int) (bool, error) {
+	values, ok := g.enums[field.Type()]
+	if !ok {
+		return false, nil
+	}
+	i := index % len(values)
+	if g.rand != nil {
+		i = g.rand.Intn(len(values))
+	}
+	return true, setConverted(field, path, values[i])
+}
@@ -0,0 +1,49 @@
+package ggda
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// UnsupportedFieldError reports a field that could not be populated in strict mode
+type UnsupportedFieldError struct {
+	Field  string
+	Kind   reflect.Kind
+	Reason string
+}
+
+func (e *UnsupportedFieldError) Error() string {
+	return fmt.Sprintf("ggda: field %s: %s (%s)", e.Field, e.Reason, e.Kind)
+}
+
+// Strict makes GenerateE and the other error-returning methods fail with
+// UnsupportedFieldError for every field that cannot be populated
+// Outside strict mode such fields are silently left zero
+func (g *Generator[T]) Strict(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.strict = enabled
+	return g
+}
+
+// unsupported returns an UnsupportedFieldError for a field that cannot be populated
+// fillStruct drops these errors outside strict mode, after noting the field was left zero
+func (g *Generator[T]) unsupported(path string, kind reflect.Kind, reason string) error {
+	return &UnsupportedFieldError{Field: path, Kind: kind, Reason: reason}
+}
+
+// isUnsupported reports whether err consists only of UnsupportedFieldErrors
+// Such errors are collected so every unsupported field is reported at once
+func isUnsupported(err error) bool {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			if !isUnsupported(e) {
+				return false
+			}
+		}
+		return true
+	}
+	var unsupported *UnsupportedFieldError
+	return errors.As(err, &unsupported)
+}
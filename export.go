@@ -0,0 +1,40 @@
+package ggda
+
+import (
+	"reflect"
+	"strings"
+)
+
+// exportedFields returns the exported top-level fields of t in declaration order
+func exportedFields(t reflect.Type) []reflect.StructField {
+	fields := make([]reflect.StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.IsExported() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// externalName returns the name a field is exported under, following its json tag
+// ok is false for fields tagged `json:"-"`, which are left out of exports
+func externalName(f reflect.StructField) (name string, omitEmpty bool, ok bool) {
+	tag, hasTag := f.Tag.Lookup("json")
+	if !hasTag {
+		return f.Name, false, true
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "-" && opts == "" {
+		return "", false, false
+	}
+	if name == "" {
+		name = f.Name
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, true
+}
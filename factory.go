@@ -0,0 +1,40 @@
+package ggda
+
+import (
+	"reflect"
+)
+
+// GenerateSliceFactory creates count values with factory and fills their zero fields
+// factory can return struct values, struct pointers or interfaces holding struct pointers,
+// so types that need a constructor or an interface element type can still be generated
+// Fields set by factory are kept; other values are returned as they are
+func GenerateSliceFactory[T any](count int, factory func(index int) T) []T {
+	gen := New[T]()
+	result := make([]T, count)
+	for i := 0; i < count; i++ {
+		result[i] = factory(i)
+		if err := gen.fillFactoryValue(reflect.ValueOf(&result[i]).Elem(), i); err != nil {
+			panic(err)
+		}
+	}
+	return result
+}
+
+// fillFactoryValue fills the struct behind v, looking through interfaces and pointers
+func (g *Generator[T]) fillFactoryValue(v reflect.Value, index int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct || !v.CanSet() {
+		return nil
+	}
+	g.fillRoot = v
+	defer func() { g.fillRoot = reflect.Value{} }()
+	return g.fillStruct(v, index, 0, "")
+}
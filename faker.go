@@ -0,0 +1,91 @@
+package ggda
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	fakersMu sync.RWMutex
+	fakers   = map[string]func(index int) string{
+		"name":    fakeName,
+		"email":   fakeEmail,
+		"uuid":    fakeUUID,
+		"phone":   fakePhone,
+		"address": fakeAddress,
+		"url":     fakeURL,
+	}
+)
+
+var (
+	firstNames = []string{"James", "Mary", "John", "Patricia", "Robert", "Jennifer", "Michael", "Linda", "William", "Elizabeth"}
+	lastNames  = []string{"Smith", "Johnson", "Williams", "Brown", "Jones", "Garcia", "Miller", "Davis", "Rodriguez", "Martinez"}
+	streets    = []string{"Main St", "Oak Ave", "Maple Dr", "Cedar Ln", "Elm St"}
+	cities     = []string{"Springfield", "Riverside", "Franklin", "Greenville", "Fairview"}
+)
+
+// RegisterFaker registers a generator for string fields tagged with `ggda:"<name>"`
+// Registering an existing name replaces it
+func RegisterFaker(name string, fn func(index int) string) {
+	fakersMu.Lock()
+	defer fakersMu.Unlock()
+	fakers[name] = fn
+}
+
+// lookupFaker returns the faker named by a valueless tag option
+// Fakers registered for locale win over the default ones
+func lookupFaker(opts tagOptions, locale string) (func(index int) string, bool) {
+	names := make([]string, 0, len(opts))
+	for key, value := range opts {
+		if value == "" {
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+
+	fakersMu.RLock()
+	defer fakersMu.RUnlock()
+	for _, name := range names {
+		if fn, ok := localeFakers[locale][name]; ok {
+			return fn, true
+		}
+		if fn, ok := fakers[name]; ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+// fakeNameParts returns the first and last name for an index
+func fakeNameParts(index int) (string, string) {
+	first := firstNames[index%len(firstNames)]
+	last := lastNames[(index/len(firstNames))%len(lastNames)]
+	return first, last
+}
+
+func fakeName(index int) string {
+	first, last := fakeNameParts(index)
+	return first + " " + last
+}
+
+func fakeEmail(index int) string {
+	first, last := fakeNameParts(index)
+	return fmt.Sprintf("%s.%s%d@example.com", strings.ToLower(first), strings.ToLower(last), index+1)
+}
+
+// fakeUUID returns a version 4 formatted UUID derived from the index
+func fakeUUID(index int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012x", index+1)
+}
+
+func fakePhone(index int) string {
+	return fmt.Sprintf("+1-555-%03d-%04d", (index/10000)%1000, index%10000)
+}
+
+var fakeAddress = localeAddress(streets, cities, "%d %s, %s")
+
+func fakeURL(index int) string {
+	return fmt.Sprintf(urlFormat, index+1)
+}
@@ -0,0 +1,57 @@
+package ggda
+
+import (
+	"strings"
+)
+
+// Only restricts generation to the listed fields and leaves every other field zero
+// Nested fields can be listed with dotted paths; listing a struct includes all of its fields
+func (g *Generator[T]) Only(fieldNames ...string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, name := range fieldNames {
+		g.onlyFields[name] = true
+	}
+	return g
+}
+
+// Exclude leaves the listed fields zero, ignoring any customs or defaults for them
+func (g *Generator[T]) Exclude(fieldNames ...string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, name := range fieldNames {
+		g.excludeFields[name] = true
+	}
+	return g
+}
+
+// noFieldLimit disables LimitFields
+const noFieldLimit = -1
+
+// LimitFields makes generation populate only the first k top-level fields and leave the rest zero
+// Only fields that get a value count, so those skipped by Only, Exclude, Fill or the sometimes tag
+// and unsupported ones don't use up the budget; a negative k removes the limit
+// Varying k across runs explores partially populated values, e.g. for fuzz seeds
+func (g *Generator[T]) LimitFields(k int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.fieldLimit = max(k, noFieldLimit)
+	return g
+}
+
+// included reports whether the field at path should be filled according to Only and Exclude
+func (g *Generator[T]) included(path string) bool {
+	if g.excludeFields[path] {
+		return false
+	}
+	if len(g.onlyFields) == 0 {
+		return true
+	}
+	for only := range g.onlyFields {
+		// the field itself, a field inside it, or a struct leading to it
+		if path == only || strings.HasPrefix(path, only+".") || strings.HasPrefix(only, path+".") {
+			return true
+		}
+	}
+	return false
+}
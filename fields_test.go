@@ -0,0 +1,21 @@
+package ggda
+
+import (
+	"testing"
+)
+
+func TestLimitFieldsCountsPopulatedFields(t *testing.T) {
+	type item struct {
+		Ch   chan int
+		S    string `ggda:"sometimes=0"`
+		A, B string
+		C    string
+	}
+	v, _ := New[item]().LimitFields(2).GenerateOneE()
+	if v.A == "" || v.B == "" {
+		t.Errorf("A, B = %q, %q; want both populated", v.A, v.B)
+	}
+	if v.Ch != nil || v.S != "" || v.C != "" {
+		t.Errorf("Ch, S, C = %v, %q, %q; want them left zero", v.Ch, v.S, v.C)
+	}
+}
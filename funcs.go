@@ -0,0 +1,26 @@
+package ggda
+
+import (
+	"reflect"
+)
+
+// StubFuncs sets func-typed fields like OnDone func() to no-op functions
+// The stubs return the zero value of every result, so callers never call a nil func
+// Without it func fields are left nil, or reported as unsupported in strict mode
+func (g *Generator[T]) StubFuncs(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.stubFuncs = enabled
+	return g
+}
+
+// stubFunc returns a function of type t that does nothing and returns zero values
+func stubFunc(t reflect.Type) reflect.Value {
+	return reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value {
+		results := make([]reflect.Value, t.NumOut())
+		for i := range results {
+			results[i] = reflect.Zero(t.Out(i))
+		}
+		return results
+	})
+}
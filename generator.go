@@ -3,157 +3,942 @@
 package ggda
 
 import (
+	"errors"
 	"fmt"
+	"math/rand"
 	"reflect"
-	"strings"
+	"sync"
 	"time"
 )
 
+const (
+	// defaultMaxDepth is how many levels of nested structs are filled by default
+	defaultMaxDepth = 3
+	// defaultSliceLen is how many elements are generated for slice fields by default
+	defaultSliceLen = 1
+	// defaultMapLen is how many entries are generated for map fields by default
+	defaultMapLen = 1
+	// defaultChanBuffer is the buffer size of channels returned by GenerateChan
+	defaultChanBuffer = 64
+	// primitiveName is used in place of a field name for values outside a struct
+	primitiveName = "text"
+)
+
 type Generator[T any] struct {
-	defaults map[string]interface{}
-	customs  map[string]func(index int) interface{}
+	// mu guards the configuration below and rand during generation
+	// It is released while user callbacks run, so callbacks may use the generator
+	mu *sync.Mutex
+
+	defaults         map[string]interface{}
+	customs          map[string]func(index int) interface{}
+	sliceLens        map[string]int
+	mapLens          map[string]int
+	sqlExclude       map[string]bool
+	onlyFields       map[string]bool
+	excludeFields    map[string]bool
+	ifaceImpls       map[string]reflect.Type
+	boolRates        map[string]float64
+	templates        map[string]string
+	typeDefaults     map[reflect.Type]func(index int) interface{}
+	maxDepth         int
+	fillPointers     bool
+	rand             *rand.Rand
+	seed             int64
+	chanBuffer       int
+	startIndex       int
+	timeStrategy     func(index int) time.Time
+	edgeCases        bool
+	stringProvider   StringProvider
+	beforeEach       []func(v *T, index int)
+	afterEach        []func(v *T, index int)
+	dependents       []dependent[T]
+	strict           bool
+	foreignKeys      map[string][]int
+	locale           string
+	randomUUIDs      bool
+	fieldLimit       int
+	nilRates         map[string]float64
+	stubFuncs        bool
+	nextIndex        int
+	protoSafe        bool
+	kindCustoms      map[reflect.Kind]func(index int) interface{}
+	predicateCustoms []predicateCustom
+	intStrategy      func(index int) int64
+	uintStrategy     func(index int) uint64
+	floatStrategy    func(index int) float64
+	sequences        map[string]*Sequence
+	triStateBools    bool
+	respectValidate  bool
+	invariants       []func(v *T) bool
+	invariantRetries int
+	timeLocation     *time.Location
+	mutexGroups      [][]string
+	ctxCustoms       map[string]func(ctx FieldContext) interface{}
+	fillRoot         reflect.Value
+	fastPath         func(elem *T, index int)
+	dictionaries     map[string][]string
+	enums            map[reflect.Type][]interface{}
+	timeOrders       []timeOrder
+	populated        map[string]bool
 }
 
 func New[T any]() *Generator[T] {
 	return &Generator[T]{
-		defaults: make(map[string]interface{}),
-		customs:  make(map[string]func(index int) interface{}),
+		mu: &sync.Mutex{},
+
+		defaults:      make(map[string]interface{}),
+		customs:       make(map[string]func(index int) interface{}),
+		sliceLens:     make(map[string]int),
+		mapLens:       make(map[string]int),
+		sqlExclude:    make(map[string]bool),
+		onlyFields:    make(map[string]bool),
+		excludeFields: make(map[string]bool),
+		ifaceImpls:    make(map[string]reflect.Type),
+		boolRates:     make(map[string]float64),
+		templates:     make(map[string]string),
+		typeDefaults:  make(map[reflect.Type]func(index int) interface{}),
+		maxDepth:      defaultMaxDepth,
+		fillPointers:  true,
+		chanBuffer:    defaultChanBuffer,
+		foreignKeys:   make(map[string][]int),
+		locale:        defaultLocale,
+		fieldLimit:    noFieldLimit,
+		nilRates:      make(map[string]float64),
+		kindCustoms:   make(map[reflect.Kind]func(index int) interface{}),
+		sequences:     make(map[string]*Sequence),
+		ctxCustoms:    make(map[string]func(ctx FieldContext) interface{}),
+		dictionaries:  make(map[string][]string),
+		enums:         make(map[reflect.Type][]interface{}),
 	}
 }
 
 // Generate creates a slice of structs with the specified count
+// It panics if a configured value cannot be assigned to its field
 func (g *Generator[T]) Generate(count int) []T {
-	result := make([]T, count)
-	for i := 0; i < count; i++ {
-		var elem T
-		v := reflect.ValueOf(&elem).Elem()
-		g.fillStruct(v, i)
-		result[i] = elem
+	result, err := g.GenerateE(count)
+	if err != nil {
+		panic(err)
 	}
 	return result
 }
 
+// GenerateE creates a slice of structs with the specified count
+// It returns an error if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateE(count int) ([]T, error) {
+	result := make([]T, count)
+	if err := g.GenerateInto(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GenerateMapByField generates count structs and indexes them by the value of fieldName
+// fieldName may be a dotted path; duplicate keys return an error rather than overwriting items,
+// so pair it with a sequence or unique customs when generated values could collide
+func (g *Generator[T]) GenerateMapByField(count int, fieldName string) (map[interface{}]T, error) {
+	f, err := resolvePath(reflect.TypeOf((*T)(nil)).Elem(), fieldName)
+	if err != nil {
+		return nil, err
+	}
+	if !f.Type.Comparable() {
+		return nil, fmt.Errorf("ggda: field %s: %s cannot be used as a map key", fieldName, f.Type)
+	}
+	items, err := g.GenerateE(count)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[interface{}]T, count)
+	seen := make(map[interface{}]int, count)
+	for i := range items {
+		v, err := fieldByPath(reflect.ValueOf(&items[i]).Elem(), fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if !v.Comparable() {
+			return nil, fmt.Errorf("ggda: field %s: item %d holds %s, which cannot be used as a map key", fieldName, i, v.Elem().Type())
+		}
+		key := v.Interface()
+		if j, ok := seen[key]; ok {
+			return nil, fmt.Errorf("ggda: field %s: items %d and %d share the key %v", fieldName, j, i, key)
+		}
+		seen[key] = i
+		result[key] = items[i]
+	}
+	return result, nil
+}
+
+// GenerateInto fills exactly len(dst) items of dst without allocating a new slice
+// Each item is generated with its position in dst as the index; capacity beyond len is ignored
+func (g *Generator[T]) GenerateInto(dst []T) error {
+	if err := g.validatePaths(); err != nil {
+		return err
+	}
+	for i := range dst {
+		elem, err := g.generateSingle(i)
+		if err != nil {
+			return err
+		}
+		dst[i] = elem
+	}
+	return nil
+}
+
+// GenerateFunc creates count structs seeded by fn and completed by the generator
+// fn runs first for each index, then its zero fields are filled as Fill would;
+// fn gets the same index as hooks and sequences, shifted by WithStartIndex
+func (g *Generator[T]) GenerateFunc(count int, fn func(index int) T) ([]T, error) {
+	if err := g.validatePaths(); err != nil {
+		return nil, err
+	}
+	g.mu.Lock()
+	start := g.startIndex
+	g.mu.Unlock()
+	result := make([]T, count)
+	for i := range result {
+		result[i] = fn(i + start)
+		if err := g.fillSeeded(&result[i], i); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// fillSeeded fills the zero fields of elem, holding the lock for one element
+func (g *Generator[T]) fillSeeded(elem *T, index int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.fillLocked(elem, index)
+}
+
 func (g *Generator[T]) GenerateOne() T {
-	var elem T
-	v := reflect.ValueOf(&elem).Elem()
-	g.fillStruct(v, 0)
+	elem, err := g.GenerateOneE()
+	if err != nil {
+		panic(err)
+	}
 	return elem
 }
 
+// GenerateOneE creates a single struct, returning an error instead of panicking
+func (g *Generator[T]) GenerateOneE() (T, error) {
+	if err := g.validatePaths(); err != nil {
+		var zero T
+		return zero, err
+	}
+	return g.generateSingle(0)
+}
+
+// generateSingle generates a single struct at the given index
+// It holds the lock for one element, apart from user callbacks, so configuration can change between elements
+func (g *Generator[T]) generateSingle(index int) (T, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.generateLocked(index)
+}
+
+// generateLocked generates a single struct at the given index with the lock held
+func (g *Generator[T]) generateLocked(index int) (T, error) {
+	var elem T
+	if err := g.fillLocked(&elem, index); err != nil {
+		var zero T
+		return zero, err
+	}
+	return elem, nil
+}
+
+// Fill fills the zero fields of an existing value, leaving non-zero fields untouched
+// Partially set structs count as non-zero and are left as they are
+func (g *Generator[T]) Fill(ptr *T, index int) error {
+	if err := g.validatePaths(); err != nil {
+		return err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.fillLocked(ptr, index)
+}
+
+// fillLocked fills the zero fields of elem with the lock held
+// The index is offset by the start index before it reaches the fill logic
+func (g *Generator[T]) fillLocked(elem *T, index int) error {
+	index += g.startIndex
+	g.nextIndex = max(g.nextIndex, index+1)
+	return g.fillChecked(elem, index)
+}
+
+// fillOnce runs the hooks and fills elem at an index that already includes the start index
+func (g *Generator[T]) fillOnce(elem *T, index int) error {
+	for _, fn := range g.beforeEach {
+		g.unlocked(func() { fn(elem, index) })
+	}
+	v := reflect.ValueOf(elem).Elem()
+	preset := g.presetMembers(v)
+	if err := g.fillFields(v, elem, index); err != nil {
+		return err
+	}
+	if err := g.applyMutexGroups(v, index, preset); err != nil {
+		return err
+	}
+	if err := g.applyTimeOrders(v, index); err != nil {
+		return err
+	}
+	if err := g.applyDependents(elem, index); err != nil {
+		return err
+	}
+	for _, fn := range g.afterEach {
+		g.unlocked(func() { fn(elem, index) })
+	}
+	return nil
+}
+
+// fillFields fills the fields of elem with the fast path if one is set, or by reflection
+func (g *Generator[T]) fillFields(v reflect.Value, elem *T, index int) error {
+	if fn := g.fastPath; fn != nil {
+		g.unlocked(func() { fn(elem, index) })
+		return nil
+	}
+	g.fillRoot = v
+	defer func() { g.fillRoot = reflect.Value{} }()
+	return g.fillStruct(v, index, 0, "")
+}
+
+// unlocked runs a user callback without holding g.mu, so the callback can call the generator itself
+// Other goroutines may fill values in the meantime, so the fill state is restored once the lock is retaken
+func (g *Generator[T]) unlocked(fn func()) {
+	root, populated := g.fillRoot, g.populated
+	g.populated = nil
+	g.mu.Unlock()
+	defer func() {
+		g.mu.Lock()
+		g.fillRoot, g.populated = root, populated
+	}()
+	fn()
+}
+
+// SetFastPath replaces reflection-based filling with fn, which fills elem by hand
+// Per-field configuration and tags are then ignored, while hooks, dependents and invariants still run
+// It suits large counts of simple types where reflection dominates generation time; nil restores reflection
+func (g *Generator[T]) SetFastPath(fn func(elem *T, index int)) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.fastPath = fn
+	return g
+}
+
 // SetDefaults sets default values for specific fields
+// Nested fields are addressed with dotted paths like "Profile.Bio"
 func (g *Generator[T]) SetDefaults(fieldName string, value interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
 	g.defaults[fieldName] = value
 	return g
 }
 
 // SetCustom sets a custom generator function for a specific field
+// Nested fields are addressed with dotted paths like "Address.Zip"
+// Fields of embedded structs are addressed by their promoted names
 func (g *Generator[T]) SetCustom(fieldName string, fn func(index int) interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.ctxCustoms, fieldName)
 	g.customs[fieldName] = fn
 	return g
 }
 
+// RegisterTypeDefault sets a generator function for every field of type t
+// Per-field customs and defaults still take precedence
+func (g *Generator[T]) RegisterTypeDefault(t reflect.Type, fn func(index int) interface{}) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.typeDefaults[t] = fn
+	return g
+}
+
+// BeforeEach registers a hook that runs on each element before it is filled
+// Fields the hook sets to non-zero values are kept as they are
+func (g *Generator[T]) BeforeEach(fn func(v *T, index int)) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.beforeEach = append(g.beforeEach, fn)
+	return g
+}
+
+// AfterEach registers a hook that runs on each element once all of its fields are filled
+func (g *Generator[T]) AfterEach(fn func(v *T, index int)) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.afterEach = append(g.afterEach, fn)
+	return g
+}
+
+// SetSliceLen sets how many elements are generated for a slice field
+// Elements are filled like fields of their type, so []*LineItem gets non-nil, filled pointers
+func (g *Generator[T]) SetSliceLen(fieldName string, n int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.sliceLens[fieldName] = n
+	return g
+}
+
+// SetMapLen sets how many entries are generated for a map field
+func (g *Generator[T]) SetMapLen(fieldName string, n int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.mapLens[fieldName] = n
+	return g
+}
+
+// SetMaxDepth sets how many levels of nested structs are filled, 3 by default
+// Fields nested deeper than this are left as their zero value, so pointers,
+// slices and maps of structs are nil and self-referential types terminate
+func (g *Generator[T]) SetMaxDepth(depth int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.maxDepth = depth
+	return g
+}
+
+// LastIndex returns the highest index generated so far, including the start index, or -1
+// A later batch can continue with WithStartIndex(g.LastIndex() + 1)
+func (g *Generator[T]) LastIndex() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.nextIndex - 1
+}
+
+// WithStartIndex makes generation begin at index n instead of 0
+// A second batch can start where the first left off to avoid colliding IDs
+func (g *Generator[T]) WithStartIndex(n int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.startIndex = n
+	return g
+}
+
+// FillPointers sets whether pointer fields are allocated and filled
+// Pointer fields are filled by default; pass false to leave them nil
+func (g *Generator[T]) FillPointers(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.fillPointers = enabled
+	return g
+}
+
 // fillStruct fills a struct with test data
 // This method is public so that it can be used by Builder
-func (g *Generator[T]) fillStruct(v reflect.Value, index int) {
-	t := v.Type()
+// depth is the nesting level of v, where 0 is the top-level struct
+// prefix is the dotted path of v, ending with a dot for nested structs
+func (g *Generator[T]) fillStruct(v reflect.Value, index int, depth int, prefix string) error {
+	var unsupported []error
+	filled := 0
 
-	for i := 0; i < v.NumField(); i++ {
+	for i, info := range cachedFields(v.Type()) {
 		field := v.Field(i)
-		fieldType := t.Field(i)
-		fieldName := fieldType.Name
+		fieldType := info.StructField
+		path := prefix + fieldType.Name
 
-		// Skip unexported fields
-		if !field.CanSet() {
+		// Skip unexported fields and fields tagged `ggda:"-"`
+		if !field.CanSet() || info.ignored {
 			continue
 		}
 
+		// Skip protobuf internals in ProtoSafe mode
+		if g.protoSafe && info.protoInternal {
+			continue
+		}
+
+		// Skip fields left out by Only or Exclude
+		if !g.included(path) {
+			continue
+		}
+
+		// Keep values that are already set, e.g. by Fill
+		if !field.IsZero() {
+			g.markPopulated(path)
+			continue
+		}
+
+		// Stop once LimitFields top-level fields are populated
+		if depth == 0 && g.fieldLimit != noFieldLimit && filled >= g.fieldLimit {
+			break
+		}
+
 		// Check for custom generator
-		if customFn, ok := g.customs[fieldName]; ok {
-			field.Set(reflect.ValueOf(customFn(index)))
+		if customFn, ok := g.customs[path]; ok {
+			var value interface{}
+			g.unlocked(func() { value = customFn(index) })
+			if err := setValue(field, path, value); err != nil {
+				return err
+			}
+			filled++
+			g.markPopulated(path)
+			continue
+		}
+
+		// Check for a custom that reads the fields filled so far
+		if customFn, ok := g.ctxCustoms[path]; ok {
+			ctx := FieldContext{Index: index, Field: path, root: g.fillRoot}
+			var value interface{}
+			g.unlocked(func() { value = customFn(ctx) })
+			if err := setValue(field, path, value); err != nil {
+				return err
+			}
+			filled++
+			g.markPopulated(path)
 			continue
 		}
 
 		// Check for default value
-		if defaultVal, ok := g.defaults[fieldName]; ok {
-			field.Set(reflect.ValueOf(defaultVal))
+		if defaultVal, ok := g.defaults[path]; ok {
+			if err := setValue(field, path, defaultVal); err != nil {
+				return err
+			}
+			filled++
+			g.markPopulated(path)
+			continue
+		}
+
+		// Leave sparse fields zero in part of the items
+		if skip, err := g.skipSometimes(fieldType, path, index); skip || err != nil {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Check for a foreign key pool
+		if pool, ok := g.foreignKeys[path]; ok {
+			if err := g.fillForeignKey(field, path, pool, index); err != nil {
+				return err
+			}
+			filled++
+			g.markPopulated(path)
+			continue
+		}
+
+		// Check for a sequence
+		if seq, ok := g.sequences[path]; ok {
+			if err := setInteger(field, path, "sequence value", seq.Next()); err != nil {
+				return err
+			}
+			filled++
+			g.markPopulated(path)
+			continue
+		}
+
+		// Check for a custom matching the field's kind or a predicate
+		if customFn, ok := g.matchCustom(fieldType); ok {
+			var value interface{}
+			g.unlocked(func() { value = customFn(index) })
+			if err := setConverted(field, path, value); err != nil {
+				return err
+			}
+			filled++
+			g.markPopulated(path)
+			continue
+		}
+
+		// Embedded structs are filled in place so promoted names match
+		if fieldType.Anonymous && isPlainStruct(elemType(fieldType.Type)) {
+			if err := g.fillEmbedded(field, index, depth, prefix); err != nil {
+				if !isUnsupported(err) {
+					return err
+				}
+				if g.strict {
+					unsupported = append(unsupported, err)
+				}
+				continue
+			}
+			filled++
 			continue
 		}
 
 		// Auto-generate based on type
-		g.autoFill(field, fieldType, index)
+		if err := g.autoFill(field, fieldType, path, index, depth); err != nil {
+			if !isUnsupported(err) {
+				return err
+			}
+			if g.strict {
+				unsupported = append(unsupported, err)
+			}
+			continue
+		}
+		filled++
+		g.markPopulated(path)
 	}
+	return errors.Join(unsupported...)
+}
+
+// fillEmbedded fills an embedded struct or struct pointer using the parent's prefix
+func (g *Generator[T]) fillEmbedded(field reflect.Value, index int, depth int, prefix string) error {
+	if depth >= g.maxDepth {
+		return nil
+	}
+	if field.Kind() != reflect.Ptr {
+		return g.fillStruct(field, index, depth+1, prefix)
+	}
+	if !g.fillPointers || field.Type().Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	ptr := reflect.New(field.Type().Elem())
+	if err := g.fillStruct(ptr.Elem(), index, depth+1, prefix); err != nil {
+		return err
+	}
+	field.Set(ptr)
+	return nil
+}
+
+// isPlainStruct reports whether t is a struct that is filled field by field
+func isPlainStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// setValue assigns a configured value to a field
+// A nil value sets the field to its zero value
+func setValue(field reflect.Value, fieldName string, value interface{}) error {
+	if value == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if !rv.Type().AssignableTo(field.Type()) {
+		return fmt.Errorf("ggda: field %s: cannot assign value of type %s to type %s", fieldName, rv.Type(), field.Type())
+	}
+	field.Set(rv)
+	return nil
 }
 
 // autoFill automatically fills a field based on its type
-func (g *Generator[T]) autoFill(field reflect.Value, fieldType reflect.StructField, index int) {
+// path is the dotted path of the field used to look up per-field settings
+func (g *Generator[T]) autoFill(field reflect.Value, fieldType reflect.StructField, path string, index int, depth int) error {
+	opts := cachedTag(fieldType)
+	if g.respectValidate {
+		opts = withValidateRules(opts, fieldType)
+	}
+
+	// A dictionary wins over oneof and every other tag
+	if field.Kind() == reflect.String {
+		if value, ok := g.dictionaryValue(path, index); ok {
+			field.SetString(value)
+			return nil
+		}
+	}
+
+	// Pick from an explicit set of values
+	if ok, err := g.pickOneOf(field, opts, path, index); ok || err != nil {
+		return err
+	}
+
+	// Check for a generator registered for the field's type
+	if fn, ok := g.typeDefaults[field.Type()]; ok {
+		var value interface{}
+		g.unlocked(func() { value = fn(index) })
+		return setValue(field, path, value)
+	}
+
+	// Restrict enum types to their registered values
+	if ok, err := g.fillEnum(field, path, index); ok || err != nil {
+		return err
+	}
+
+	// Substitute boundary values when edge cases are enabled
+	if g.fillEdgeCase(field, index) {
+		return nil
+	}
+
+	// Addresses and URLs are structs or byte slices that need a valid form
+	if g.fillNetwork(field, index) {
+		return nil
+	}
+
+	// Arbitrary precision numbers are structs with unexported state
+	if ok, err := g.fillBig(field, opts, path, index); ok || err != nil {
+		return err
+	}
+
+	// sql.Null* wrappers get a valid value rather than field by field filling
+	if isSQLNull(field.Type()) {
+		return g.fillSQLNull(field, fieldType, path, index, depth)
+	}
+
+	// Durations share the int64 kind but need their own scale
+	if field.Type() == durationType {
+		d, err := g.durationValue(opts, path, index)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+
+	// UUIDs are byte arrays but need the version and variant bits
+	if isUUID(field.Type(), opts) {
+		g.fillUUID(field, path, index)
+		return nil
+	}
+
+	// Types with UnmarshalText parse a generated string
+	if ok, err := g.fillText(field, opts, fieldType, path, index); ok || err != nil {
+		return err
+	}
+
 	switch field.Kind() {
 	case reflect.String:
-		field.SetString(fmt.Sprintf("%s_%d", strings.ToLower(fieldType.Name), index+1))
+		str, err := g.stringValue(opts, fieldType, path, index)
+		if err != nil {
+			return err
+		}
+		field.SetString(str)
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		field.SetInt(int64(index + 1))
+		n, err := g.boundedInt(field, opts, path, index)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		field.SetUint(uint64(index + 1))
+		n, err := g.boundedUint(field, opts, path, index)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
 	case reflect.Float32, reflect.Float64:
-		field.SetFloat(float64(index+1) * 1.1)
+		f, err := g.boundedFloat(opts, path, index)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
 	case reflect.Bool:
-		field.SetBool(index%2 == 0)
+		b, err := g.rateBool(opts, path, index)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Complex64, reflect.Complex128:
+		field.SetComplex(g.complexValue(index))
 	case reflect.Struct:
-		if field.Type() == reflect.TypeOf(time.Time{}) {
-			field.Set(reflect.ValueOf(time.Now()))
+		if field.Type() == timeType {
+			field.Set(reflect.ValueOf(g.timeValue(index)))
+			return nil
+		}
+		// Recurse into nested structs until the depth limit is reached
+		if depth < g.maxDepth {
+			return g.fillStruct(field, index, depth+1, nestedPrefix(path))
+		}
+	case reflect.Ptr:
+		if !g.fillPointers {
+			return nil
+		}
+		if g.beyondDepth(field.Type(), depth) {
+			return nil
+		}
+		p, hasRate := g.nilRates[path]
+		if hasRate && g.boolWithRate(index, p) {
+			return nil
+		}
+		if g.triStateBools && !hasRate && field.Type().Elem().Kind() == reflect.Bool {
+			if b := g.triStateBool(index); b != nil {
+				ptr := reflect.New(field.Type().Elem())
+				ptr.Elem().SetBool(*b)
+				field.Set(ptr)
+			}
+			return nil
+		}
+		ptr := reflect.New(field.Type().Elem())
+		if err := g.autoFill(ptr.Elem(), fieldType, path, index, depth); err != nil {
+			return err
+		}
+		field.Set(ptr)
+	case reflect.Slice:
+		if isBytes(field.Type()) {
+			b, err := g.bytesValue(opts, path, index)
+			if err != nil {
+				return err
+			}
+			field.SetBytes(b)
+			return nil
+		}
+		if g.beyondDepth(field.Type(), depth) {
+			return nil
+		}
+		n := defaultSliceLen
+		if l, ok := g.sliceLens[path]; ok {
+			n = l
+		}
+		n, err := opts.itemCount(path, n)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.MakeSlice(field.Type(), n, n))
+		return g.fillElems(field, fieldType, path, index, depth)
+	case reflect.Array:
+		return g.fillElems(field, fieldType, path, index, depth)
+	case reflect.Map:
+		return g.fillMap(field, opts, fieldType, path, index, depth)
+	case reflect.Interface:
+		return g.fillInterface(field, fieldType, path, index, depth)
+	case reflect.Func:
+		if !g.stubFuncs {
+			return g.unsupported(path, field.Kind(), "func fields need StubFuncs")
+		}
+		field.Set(stubFunc(field.Type()))
+	default:
+		return g.unsupported(path, field.Kind(), "unsupported kind")
+	}
+	return nil
+}
+
+// fillMap creates a map and fills its keys and values
+// Keys use the same index scheme as fillElems so primitive keys don't collide
+func (g *Generator[T]) fillMap(field reflect.Value, opts tagOptions, fieldType reflect.StructField, path string, index int, depth int) error {
+	mapType := field.Type()
+	if !mapType.Key().Comparable() || g.beyondDepth(mapType, depth) {
+		return nil
+	}
+	n := defaultMapLen
+	if l, ok := g.mapLens[path]; ok {
+		n = l
+	}
+	n, err := opts.itemCount(path, n)
+	if err != nil {
+		return err
+	}
+	m := reflect.MakeMapWithSize(mapType, n)
+	for j := 0; j < n; j++ {
+		key := reflect.New(mapType.Key()).Elem()
+		if err := g.autoFill(key, fieldType, path, index*n+j, depth); err != nil {
+			return err
+		}
+		val := reflect.New(mapType.Elem()).Elem()
+		if err := g.autoFill(val, fieldType, path, index*n+j, depth); err != nil {
+			return err
+		}
+		m.SetMapIndex(key, val)
+	}
+	field.Set(m)
+	return nil
+}
+
+// nestedPrefix returns the prefix for the fields of a struct at path
+func nestedPrefix(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + "."
+}
+
+// beyondDepth reports whether a pointer, slice or map of type t holds structs
+// that are nested past the depth limit, in which case it is left nil
+func (g *Generator[T]) beyondDepth(t reflect.Type, depth int) bool {
+	return depth >= g.maxDepth && isPlainStruct(elemType(t))
+}
+
+// fillElems fills every element of a slice or array
+// Element j of the item at index gets the index index*len+j so values stay unique across items
+// Arrays keep the length of their type, so struct elements like [3]Point are all filled without SetSliceLen
+func (g *Generator[T]) fillElems(field reflect.Value, fieldType reflect.StructField, path string, index int, depth int) error {
+	n := field.Len()
+	for j := 0; j < n; j++ {
+		if err := g.autoFill(field.Index(j), fieldType, path, index*n+j, depth); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 // GenerateSlice creates a slice of structs with the specified count
 func GenerateSlice[T any](count int) []T {
+	return GenerateSliceFrom[T](0, count)
+}
+
+// GenerateSliceFrom is like GenerateSlice but starts generating at index start
+func GenerateSliceFrom[T any](start, count int) []T {
 	result := make([]T, count)
-	var zero T
-	v := reflect.ValueOf(zero)
+	t := reflect.TypeOf((*T)(nil)).Elem()
 
 	// Check if T is a struct type
-	if v.Kind() == reflect.Struct {
+	if t.Kind() == reflect.Struct {
 		// For struct types, use Generator
-		gen := New[T]()
+		gen := New[T]().WithStartIndex(start)
 		return gen.Generate(count)
 	}
 
+	// For pointers, slices, arrays and maps, fill like a struct field
+	if isContainer(t.Kind()) && !isBytes(t) {
+		gen := New[T]()
+		for i := 0; i < count; i++ {
+			elem, err := gen.generateValue(start + i)
+			if err != nil {
+				panic(err)
+			}
+			result[i] = elem
+		}
+		return result
+	}
+
 	// For primitive types, generate directly
 	for i := 0; i < count; i++ {
-		result[i] = generatePrimitive[T](i)
+		result[i] = generatePrimitive[T](start + i)
+	}
+	return result
+}
+
+// GenerateMap creates a map of count generated values keyed by keyFn
+// When keyFn returns the same key more than once the last value wins
+func GenerateMap[K comparable, T any](count int, keyFn func(item T, index int) K) map[K]T {
+	result := make(map[K]T, count)
+	for i, item := range GenerateSlice[T](count) {
+		result[keyFn(item, i)] = item
 	}
 	return result
 }
 
 // GenerateSliceWith creates a slice of structs with custom modification
 func GenerateSliceWith[T any](count int, modifier func(item *T, index int)) []T {
-	result := make([]T, count)
-	var zero T
-	v := reflect.ValueOf(zero)
+	result := GenerateSlice[T](count)
+	if modifier != nil {
+		for i := range result {
+			modifier(&result[i], i)
+		}
+	}
+	return result
+}
 
-	// Check if T is a struct type
-	if v.Kind() == reflect.Struct {
-		// For struct types, use Generator
-		gen := New[T]()
-		for i := 0; i < count; i++ {
-			var elem T
-			v := reflect.ValueOf(&elem).Elem()
-			gen.fillStruct(v, i)
-			if modifier != nil {
-				modifier(&elem, i)
-			}
-			result[i] = elem
+// GenerateSliceWithE is like GenerateSliceWith with a modifier that can fail
+// It stops at the first failing item and returns an error naming its index
+func GenerateSliceWithE[T any](count int, modifier func(item *T, index int) error) ([]T, error) {
+	var result []T
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Struct {
+		var err error
+		if result, err = New[T]().GenerateE(count); err != nil {
+			return nil, err
 		}
 	} else {
-		// For primitive types
-		for i := 0; i < count; i++ {
-			elem := generatePrimitive[T](i)
-			if modifier != nil {
-				modifier(&elem, i)
+		result = GenerateSlice[T](count)
+	}
+	if modifier != nil {
+		for i := range result {
+			if err := modifier(&result[i], i); err != nil {
+				return nil, fmt.Errorf("ggda: item %d: %w", i, err)
 			}
-			result[i] = elem
 		}
 	}
-	return result
+	return result, nil
+}
+
+// generateValue generates a non-struct value of type T the way a struct field of that type is filled
+func (g *Generator[T]) generateValue(index int) (T, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var elem T
+	v := reflect.ValueOf(&elem).Elem()
+	if err := g.autoFill(v, reflect.StructField{Name: primitiveName}, "", index, 0); err != nil {
+		var zero T
+		if isUnsupported(err) && !g.strict {
+			return zero, nil
+		}
+		return zero, err
+	}
+	return elem, nil
 }
 
 // generatePrimitive generates a primitive value
@@ -163,7 +948,7 @@ func generatePrimitive[T any](index int) T {
 
 	switch v.Kind() {
 	case reflect.String:
-		v.SetString(fmt.Sprintf("text_%d", index+1))
+		v.SetString(fmt.Sprintf("%s_%d", primitiveName, index+1))
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		v.SetInt(int64(index + 1))
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
@@ -172,6 +957,14 @@ func generatePrimitive[T any](index int) T {
 		v.SetFloat(float64(index+1) * 1.1)
 	case reflect.Bool:
 		v.SetBool(index%2 == 0)
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(float64(index+1), float64(index+1)))
+	case reflect.Slice:
+		if isBytes(v.Type()) {
+			b := make([]byte, defaultBytesLen)
+			fillBytes(b, index)
+			v.SetBytes(b)
+		}
 	}
 
 	return result
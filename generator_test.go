@@ -0,0 +1,118 @@
+package ggda
+
+import (
+	"testing"
+)
+
+type callbackItem struct {
+	A int
+	B string
+}
+
+func TestCallbacksCanUseGenerator(t *testing.T) {
+	g := New[callbackItem]()
+	g.SetCustom("A", func(int) interface{} { return g.LastIndex() })
+	g.BeforeEach(func(v *callbackItem, index int) { g.SetTemplate("B", "b-%d") })
+	g.AfterEach(func(v *callbackItem, index int) { _ = g.Clone() })
+
+	items := g.Generate(2)
+	if items[0].A != 0 || items[1].A != 1 {
+		t.Errorf("A = %d, %d; want 0, 1", items[0].A, items[1].A)
+	}
+	if items[1].B != "b-2" {
+		t.Errorf("B = %q; want %q", items[1].B, "b-2")
+	}
+	if got := g.GenerateParallel(4, 2); len(got) != 4 {
+		t.Errorf("GenerateParallel returned %d items; want 4", len(got))
+	}
+}
+
+type Coordinate struct {
+	Lat, Lng float64
+	Label    string
+}
+
+func TestArrayOfStructs(t *testing.T) {
+	type route struct {
+		Stops [3]Coordinate
+	}
+	stops := New[route]().GenerateOne().Stops
+	seen := make(map[Coordinate]bool)
+	for i, c := range stops {
+		if c.Lat == 0 || c.Lng == 0 || c.Label == "" {
+			t.Errorf("Stops[%d] = %+v; want every field filled", i, c)
+		}
+		if seen[c] {
+			t.Errorf("Stops[%d] = %+v; want distinct values", i, c)
+		}
+		seen[c] = true
+	}
+}
+
+type LineItem struct {
+	SKU      string
+	Quantity int
+	Price    float64
+}
+
+func TestSliceOfStructPointers(t *testing.T) {
+	type order struct {
+		Items []*LineItem
+	}
+	items := New[order]().SetSliceLen("Items", 3).GenerateOne().Items
+	if len(items) != 3 {
+		t.Fatalf("len(Items) = %d; want 3", len(items))
+	}
+	for i, item := range items {
+		if item == nil {
+			t.Fatalf("Items[%d] is nil", i)
+		}
+		if item.SKU == "" || item.Quantity == 0 || item.Price == 0 {
+			t.Errorf("Items[%d] = %+v; want every field filled", i, *item)
+		}
+	}
+}
+
+type mutexItem struct {
+	A string
+	B string
+}
+
+func TestMutexGroupKeepsPresetField(t *testing.T) {
+	g := New[mutexItem]().SetMutexGroup("A", "B")
+	item := mutexItem{B: "mine"}
+	if err := g.Fill(&item, 0); err != nil {
+		t.Fatal(err)
+	}
+	if item.A != "" || item.B != "mine" {
+		t.Errorf("Fill = %+v; want only B kept", item)
+	}
+
+	items, err := g.GenerateFunc(2, func(int) mutexItem { return mutexItem{A: "seeded"} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range items {
+		if item.A != "seeded" || item.B != "" {
+			t.Errorf("GenerateFunc item = %+v; want only A kept", item)
+		}
+	}
+}
+
+func TestGenerateFuncShiftsIndex(t *testing.T) {
+	g := New[callbackItem]().WithStartIndex(10)
+	var hooked []int
+	g.BeforeEach(func(v *callbackItem, index int) { hooked = append(hooked, index) })
+	items, err := g.GenerateFunc(2, func(index int) callbackItem { return callbackItem{A: index} })
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, item := range items {
+		if item.A != hooked[i] {
+			t.Errorf("fn index = %d; want hook index %d", item.A, hooked[i])
+		}
+	}
+	if items[0].A != 10 {
+		t.Errorf("first fn index = %d; want 10", items[0].A)
+	}
+}
@@ -0,0 +1,75 @@
+// Package ggdatest provides test helpers for ggda generators
+// It is kept apart from ggda so that importing ggda does not link testing or register flags
+package ggdatest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ntk221/ggda"
+)
+
+// updateGolden rewrites golden files instead of comparing against them
+var updateGolden = flag.Bool("ggda.update", false, "update ggda golden files")
+
+// Golden generates count structs with g as indented JSON and compares them with the file at path
+// Running the test with -ggda.update, or with an -update flag defined by the test package,
+// writes the file instead; combine with WithSeed or the index-based defaults for stable output
+func Golden[T any](t testing.TB, g *ggda.Generator[T], path string, count int) {
+	t.Helper()
+	got, err := g.GenerateJSONIndent(count, "", "  ")
+	if err != nil {
+		t.Fatalf("ggda: golden %s: %v", path, err)
+	}
+	got = append(got, '\n')
+
+	if shouldUpdateGolden() {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("ggda: golden %s: %v", path, err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("ggda: golden %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ggda: golden %s: %v (run with -ggda.update to create it)", path, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("ggda: golden %s does not match the generated output\n%s", path, firstDiff(string(want), string(got)))
+	}
+}
+
+// shouldUpdateGolden reports whether golden files should be rewritten
+func shouldUpdateGolden() bool {
+	if *updateGolden {
+		return true
+	}
+	f := flag.Lookup("update")
+	return f != nil && f.Value.String() == "true"
+}
+
+// firstDiff describes the first line that differs between want and got
+func firstDiff(want, got string) string {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return fmt.Sprintf("line %d:\n- %s\n+ %s", i+1, w, g)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,29 @@
+package ggdatest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ntk221/ggda"
+)
+
+type goldenItem struct {
+	ID   int
+	Name string
+}
+
+func TestGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "items.golden")
+	if err := os.WriteFile(path, []byte("[\n  {\n    \"ID\": 1,\n    \"Name\": \"name_1\"\n  }\n]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	Golden(t, ggda.New[goldenItem](), path, 1)
+}
+
+func TestFirstDiff(t *testing.T) {
+	got := firstDiff("a\nb\nc", "a\nx\nc")
+	if want := "line 2:\n- b\n+ x"; got != want {
+		t.Errorf("firstDiff = %q; want %q", got, want)
+	}
+}
@@ -1,3 +1,5 @@
 module github.com/ntk221/ggda
 
 go 1.24.0
+
+require google.golang.org/protobuf v1.36.12
@@ -0,0 +1,42 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// RegisterInterfaceImpl makes an interface-typed field hold a generated value of concrete's type
+// It returns an error if the field is not an interface or concrete's type does not implement it
+func (g *Generator[T]) RegisterInterfaceImpl(fieldName string, concrete interface{}) error {
+	field, err := resolvePath(reflect.TypeOf((*T)(nil)).Elem(), fieldName)
+	if err != nil {
+		return err
+	}
+	if field.Type.Kind() != reflect.Interface {
+		return fmt.Errorf("ggda: field %s: %s is not an interface", fieldName, field.Type)
+	}
+	impl := reflect.TypeOf(concrete)
+	if impl == nil || !impl.Implements(field.Type) {
+		return fmt.Errorf("ggda: field %s: %v does not implement %s", fieldName, impl, field.Type)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.ifaceImpls[fieldName] = impl
+	return nil
+}
+
+// fillInterface sets an interface field to a generated value of its registered implementation
+// Fields without a registered implementation are left nil
+func (g *Generator[T]) fillInterface(field reflect.Value, fieldType reflect.StructField, path string, index int, depth int) error {
+	impl, ok := g.ifaceImpls[path]
+	if !ok {
+		return g.unsupported(path, field.Kind(), "nil interface without a registered implementation")
+	}
+	v := reflect.New(impl).Elem()
+	if err := g.autoFill(v, fieldType, path, index, depth); err != nil {
+		return err
+	}
+	field.Set(v)
+	return nil
+}
@@ -0,0 +1,53 @@
+package ggda
+
+import (
+	"fmt"
+)
+
+// invariantStride separates the indexes used by retries of the same item
+const invariantStride = 1 << 20
+
+// WithInvariant makes generation reject items for which fn returns false, like Start >= End
+// A rejected item is filled again from index+n*2^20 on the nth retry, so hooks and customs see
+// the shifted index; after maxRetries retries generation fails with an error
+// With several invariants all must hold and the largest maxRetries applies
+func (g *Generator[T]) WithInvariant(fn func(v *T) bool, maxRetries int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.invariants = append(g.invariants, fn)
+	g.invariantRetries = max(g.invariantRetries, maxRetries)
+	return g
+}
+
+// invariantsHold reports whether elem satisfies every invariant
+func (g *Generator[T]) invariantsHold(elem *T) bool {
+	for _, fn := range g.invariants {
+		var ok bool
+		g.unlocked(func() { ok = fn(elem) })
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// fillChecked fills elem until it satisfies the invariants or the retries run out
+// Fields set before the call are restored for every retry
+func (g *Generator[T]) fillChecked(elem *T, index int) error {
+	if len(g.invariants) == 0 {
+		return g.fillOnce(elem, index)
+	}
+	orig := *elem
+	for attempt := 0; ; attempt++ {
+		if err := g.fillOnce(elem, index+attempt*invariantStride); err != nil {
+			return err
+		}
+		if g.invariantsHold(elem) {
+			return nil
+		}
+		if attempt >= g.invariantRetries {
+			return fmt.Errorf("ggda: item %d: invariant does not hold after %d retries", index, g.invariantRetries)
+		}
+		*elem = orig
+	}
+}
@@ -0,0 +1,119 @@
+package ggda
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// GenerateJSON generates count structs and marshals them as a JSON array
+func (g *Generator[T]) GenerateJSON(count int) ([]byte, error) {
+	result, err := g.GenerateE(count)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(result)
+}
+
+// GenerateJSONIndent is like GenerateJSON but indents the output like json.MarshalIndent
+func (g *Generator[T]) GenerateJSONIndent(count int, prefix, indent string) ([]byte, error) {
+	result, err := g.GenerateE(count)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(result, prefix, indent)
+}
+
+// WriteJSON streams count structs to w as a JSON array
+// Elements are encoded with encoding/json, so json struct tags are honored
+// Elements are generated and written one at a time so the whole slice is never held in memory
+func (g *Generator[T]) WriteJSON(w io.Writer, count int) error {
+	if err := g.validatePaths(); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	if err := bw.WriteByte('['); err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		elem, err := g.generateSingle(i)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(elem)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if err := bw.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if _, err := bw.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := bw.WriteByte(']'); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// NDJSONReader returns a reader of count structs as newline-delimited JSON, one object per line
+// Structs are generated as the reader is consumed, so memory stays bounded for any count
+// Generation errors are returned from Read
+func (g *Generator[T]) NDJSONReader(count int) io.Reader {
+	return &ndjsonReader[T]{g: g, count: count}
+}
+
+// ndjsonReader generates the next line whenever the previous one has been read
+type ndjsonReader[T any] struct {
+	g     *Generator[T]
+	count int
+	next  int
+	line  []byte
+	err   error
+}
+
+func (r *ndjsonReader[T]) Read(p []byte) (int, error) {
+	if r.err == nil && r.next == 0 {
+		r.err = r.g.validatePaths()
+	}
+	n := 0
+	for n < len(p) {
+		if len(r.line) == 0 {
+			if r.err != nil {
+				break
+			}
+			if r.next >= r.count {
+				r.err = io.EOF
+				break
+			}
+			r.line, r.err = r.nextLine()
+			if r.err != nil {
+				break
+			}
+		}
+		copied := copy(p[n:], r.line)
+		r.line = r.line[copied:]
+		n += copied
+	}
+	if n > 0 {
+		return n, nil
+	}
+	return 0, r.err
+}
+
+// nextLine generates the next struct and encodes it with a trailing newline
+func (r *ndjsonReader[T]) nextLine() ([]byte, error) {
+	elem, err := r.g.generateSingle(r.next)
+	if err != nil {
+		return nil, err
+	}
+	r.next++
+	data, err := json.Marshal(elem)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,88 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// lastNumber matches the last run of digits in a string
+var lastNumber = regexp.MustCompile(`\d+(\D*)$`)
+
+// LearnFrom configures generation to produce values resembling sample
+// Every non-zero exported field becomes per-field configuration: strings become templates
+// with their last number replaced by the generated one, or a number appended when there is none,
+// numbers become a base that grows by index, and other values become defaults
+// Nested structs are learned field by field, and learned settings replace earlier ones of the same kind
+func (g *Generator[T]) LearnFrom(sample T) *Generator[T] {
+	g.learnStruct(reflect.ValueOf(sample), "")
+	return g
+}
+
+// learnStruct learns the fields of the struct v
+func (g *Generator[T]) learnStruct(v reflect.Value, prefix string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		field := v.Field(i)
+		if !f.IsExported() || field.IsZero() {
+			continue
+		}
+		path := prefix + f.Name
+		if isPlainStruct(f.Type) && f.Type != netipType && !isSQLNull(f.Type) {
+			// embedded fields keep their promoted names
+			if f.Anonymous {
+				g.learnStruct(field, prefix)
+			} else {
+				g.learnStruct(field, nestedPrefix(path))
+			}
+			continue
+		}
+		g.learnField(field, path)
+	}
+}
+
+// learnField turns the sample value of the field at path into configuration
+func (g *Generator[T]) learnField(field reflect.Value, path string) {
+	t := field.Type()
+	switch field.Kind() {
+	case reflect.String:
+		g.SetTemplate(path, learnTemplate(field.String()))
+		return
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		base := field.Int()
+		g.SetCustom(path, func(index int) interface{} {
+			return reflect.ValueOf(base + int64(index)).Convert(t).Interface()
+		})
+		return
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		base := field.Uint()
+		g.SetCustom(path, func(index int) interface{} {
+			return reflect.ValueOf(base + uint64(index)).Convert(t).Interface()
+		})
+		return
+	case reflect.Float32, reflect.Float64:
+		base := field.Float()
+		g.SetCustom(path, func(index int) interface{} {
+			return reflect.ValueOf(base + float64(index)).Convert(t).Interface()
+		})
+		return
+	}
+	g.SetDefaults(path, field.Interface())
+}
+
+// learnTemplate turns a sample string like "user_42@example.com" into "user_%d@example.com"
+func learnTemplate(s string) string {
+	s = strings.ReplaceAll(s, "%", "%%")
+	loc := lastNumber.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return s + "_%d"
+	}
+	verb := "%d"
+	// keep zero padded numbers like 0007 the same width
+	if digits := s[loc[0]:loc[2]]; len(digits) > 1 && digits[0] == '0' {
+		verb = fmt.Sprintf("%%0%dd", len(digits))
+	}
+	return s[:loc[0]] + verb + s[loc[2]:]
+}
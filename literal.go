@@ -0,0 +1,240 @@
+package ggda
+
+import (
+	"fmt"
+	"go/format"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GenerateGoLiteral generates count structs and renders them as Go source like
+// `var users = []User{...}`, ready to paste into a fixture file
+// Zero fields are left out, times are rendered as time.Date calls and types from
+// T's package are unqualified; adding the imports the snippet needs is left to the caller
+// Funcs, channels and structs with unexported state cannot be rendered and return an error
+func (g *Generator[T]) GenerateGoLiteral(varName string, count int) (string, error) {
+	result, err := g.GenerateE(count)
+	if err != nil {
+		return "", err
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	r := literalRenderer{pkgPath: t.PkgPath()}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "var %s = []%s{\n", varName, r.typeName(t))
+	for i := range result {
+		lit, err := r.render(reflect.ValueOf(result[i]), "", true)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(lit)
+		sb.WriteString(",\n")
+	}
+	sb.WriteString("}\n")
+
+	src, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return "", fmt.Errorf("ggda: formatting Go literal: %w", err)
+	}
+	return string(src), nil
+}
+
+// literalRenderer renders values as Go expressions
+// Types from pkgPath are written without their package name
+type literalRenderer struct {
+	pkgPath string
+}
+
+// render returns v as a Go expression; elided drops the type of composite literals
+// where Go allows it, such as slice elements
+func (r literalRenderer) render(v reflect.Value, path string, elided bool) (string, error) {
+	t := v.Type()
+	if t == timeType {
+		return timeLiteral(v.Interface().(time.Time)), nil
+	}
+	if t == durationType {
+		return fmt.Sprintf("time.Duration(%d)", v.Int()), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		switch f := v.Float(); {
+		case math.IsNaN(f):
+			return "math.NaN()", nil
+		case math.IsInf(f, 0):
+			return fmt.Sprintf("math.Inf(%d)", int(math.Copysign(1, f))), nil
+		}
+		return strconv.FormatFloat(v.Float(), 'g', -1, t.Bits()), nil
+	case reflect.Complex64, reflect.Complex128:
+		return fmt.Sprintf("%s(%v)", r.typeName(t), v.Complex()), nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return "nil", nil
+		}
+		if t.Elem().Kind() == reflect.Struct && t.Elem() != timeType {
+			lit, err := r.render(v.Elem(), path, false)
+			return "&" + lit, err
+		}
+		lit, err := r.render(v.Elem(), path, false)
+		// scalars have no address-of literal, so wrap them in a func
+		return fmt.Sprintf("func() %s { v := %s(%s); return &v }()", r.typeName(t), r.typeName(t.Elem()), lit), err
+	case reflect.Interface:
+		if v.IsNil() {
+			return "nil", nil
+		}
+		lit, err := r.render(v.Elem(), path, false)
+		return r.typed(v.Elem().Type(), lit), err
+	case reflect.Struct:
+		return r.renderStruct(v, path, elided)
+	case reflect.Slice:
+		if v.IsNil() {
+			return "nil", nil
+		}
+		return r.renderElems(v, path, elided)
+	case reflect.Array:
+		return r.renderElems(v, path, elided)
+	case reflect.Map:
+		if v.IsNil() {
+			return "nil", nil
+		}
+		return r.renderMap(v, path, elided)
+	}
+	return "", fmt.Errorf("ggda: field %s: cannot render %s as a Go literal", path, t)
+}
+
+// renderStruct renders the non-zero exported fields of a struct
+func (r literalRenderer) renderStruct(v reflect.Value, path string, elided bool) (string, error) {
+	t := v.Type()
+	var sb strings.Builder
+	if !elided {
+		sb.WriteString(r.typeName(t))
+	}
+	sb.WriteString("{\n")
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := v.Field(i)
+		if fv.IsZero() {
+			continue
+		}
+		if !f.IsExported() {
+			return "", fmt.Errorf("ggda: field %s: %s has unexported state and cannot be rendered as a Go literal", path, t)
+		}
+		lit, err := r.render(fv, nestedPrefix(path)+f.Name, false)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&sb, "%s: %s,\n", f.Name, lit)
+	}
+	sb.WriteString("}")
+	return sb.String(), nil
+}
+
+// renderElems renders the elements of a slice or array
+func (r literalRenderer) renderElems(v reflect.Value, path string, elided bool) (string, error) {
+	var sb strings.Builder
+	if !elided {
+		sb.WriteString(r.typeName(v.Type()))
+	}
+	sb.WriteString("{")
+	for i := 0; i < v.Len(); i++ {
+		lit, err := r.render(v.Index(i), path, true)
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(lit)
+	}
+	sb.WriteString("}")
+	return sb.String(), nil
+}
+
+// renderMap renders a map with its entries sorted by rendered key so output is stable
+func (r literalRenderer) renderMap(v reflect.Value, path string, elided bool) (string, error) {
+	entries := make([]string, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key, err := r.render(iter.Key(), path, true)
+		if err != nil {
+			return "", err
+		}
+		value, err := r.render(iter.Value(), path, true)
+		if err != nil {
+			return "", err
+		}
+		entries = append(entries, key+": "+value)
+	}
+	slices.Sort(entries)
+
+	var sb strings.Builder
+	if !elided {
+		sb.WriteString(r.typeName(v.Type()))
+	}
+	sb.WriteString("{")
+	sb.WriteString(strings.Join(entries, ", "))
+	sb.WriteString("}")
+	return sb.String(), nil
+}
+
+// typed wraps a constant held by an interface in a conversion to its type
+// unless the constant's default type already matches, like string or int
+func (r literalRenderer) typed(t reflect.Type, lit string) string {
+	switch t {
+	case reflect.TypeOf(""), reflect.TypeOf(false), reflect.TypeOf(0):
+		return lit
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%s(%s)", r.typeName(t), lit)
+	}
+	return lit
+}
+
+// typeName returns how t is written in Go source
+func (r literalRenderer) typeName(t reflect.Type) string {
+	if t.Name() != "" {
+		if t.PkgPath() == "" || t.PkgPath() == r.pkgPath {
+			return t.Name()
+		}
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + r.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + r.typeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), r.typeName(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", r.typeName(t.Key()), r.typeName(t.Elem()))
+	}
+	return t.String()
+}
+
+// timeLiteral renders t as a time.Date call
+func timeLiteral(t time.Time) string {
+	loc := "time.UTC"
+	switch {
+	case t.Location() == time.Local:
+		loc = "time.Local"
+	case t.Location() != time.UTC:
+		name, offset := t.Zone()
+		loc = fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+	}
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
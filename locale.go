@@ -0,0 +1,100 @@
+package ggda
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+)
+
+// defaultLocale is the locale of the fakers registered with RegisterFaker
+const defaultLocale = "en"
+
+// localeFakers holds fakers that replace the default ones for a locale
+// Names missing from a locale fall back to the default fakers
+var localeFakers = map[string]map[string]func(index int) string{
+	"ja": {
+		"name":    localeName(jaFirstNames, jaLastNames, "%[2]s %[1]s"),
+		"phone":   func(index int) string { return fmt.Sprintf("+81-3-%04d-%04d", (index/10000)%10000, index%10000) },
+		"address": localeAddress(jaStreets, jaCities, "%[3]s%[2]s%[1]d-1"),
+	},
+	"de": {
+		"name":    localeName(deFirstNames, deLastNames, "%[1]s %[2]s"),
+		"phone":   func(index int) string { return fmt.Sprintf("+49-30-%04d-%04d", (index/10000)%10000, index%10000) },
+		"address": localeAddress(deStreets, deCities, "%[2]s %[1]d, %[3]s"),
+	},
+}
+
+var (
+	jaFirstNames = []string{"太郎", "花子", "健太", "さくら", "翔太", "陽菜", "大輔", "美咲", "拓也", "結衣"}
+	jaLastNames  = []string{"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤"}
+	jaStreets    = []string{"千代田区", "中央区", "港区", "新宿区", "渋谷区"}
+	jaCities     = []string{"東京都", "大阪府", "京都府", "北海道", "福岡県"}
+	deFirstNames = []string{"Lukas", "Anna", "Jürgen", "Sophie", "Maximilian", "Lena", "Felix", "Jana", "Jörg", "Marie"}
+	deLastNames  = []string{"Müller", "Schmidt", "Schneider", "Fischer", "Weber", "Meyer", "Wagner", "Becker", "Schulz", "Hoffmann"}
+	deStreets    = []string{"Hauptstraße", "Schulstraße", "Gartenstraße", "Bahnhofstraße", "Dorfstraße"}
+	deCities     = []string{"Berlin", "München", "Köln", "Düsseldorf", "Nürnberg"}
+)
+
+// SetLocale selects the locale used by faker tags, e.g. "ja" or "de"
+// Fakers without locale-specific data fall back to English; an unknown locale
+// falls back entirely, with a warning, or an UnsupportedFieldError in strict mode
+func (g *Generator[T]) SetLocale(locale string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !knownLocale(locale) && !g.strict {
+		log.Printf("ggda: unsupported locale %q, falling back to %q", locale, defaultLocale)
+	}
+	g.locale = locale
+	return g
+}
+
+// RegisterLocaleFaker registers a faker used instead of the default named one when locale is set
+func RegisterLocaleFaker(locale, name string, fn func(index int) string) {
+	fakersMu.Lock()
+	defer fakersMu.Unlock()
+	if locale == defaultLocale {
+		fakers[name] = fn
+		return
+	}
+	if localeFakers[locale] == nil {
+		localeFakers[locale] = make(map[string]func(index int) string)
+	}
+	localeFakers[locale][name] = fn
+}
+
+// knownLocale reports whether any fakers exist for locale
+func knownLocale(locale string) bool {
+	if locale == "" || locale == defaultLocale {
+		return true
+	}
+	fakersMu.RLock()
+	defer fakersMu.RUnlock()
+	_, ok := localeFakers[locale]
+	return ok
+}
+
+// checkLocale returns an UnsupportedFieldError in strict mode when the locale has no fakers
+func (g *Generator[T]) checkLocale(path string) error {
+	if !g.strict || knownLocale(g.locale) {
+		return nil
+	}
+	return g.unsupported(path, reflect.String, fmt.Sprintf("unsupported locale %q", g.locale))
+}
+
+// localeName returns a name faker; format receives the first and last name
+func localeName(first, last []string, format string) func(index int) string {
+	return func(index int) string {
+		f := first[index%len(first)]
+		l := last[(index/len(first))%len(last)]
+		return fmt.Sprintf(format, f, l)
+	}
+}
+
+// localeAddress returns an address faker; format receives the number, street and city
+func localeAddress(streets, cities []string, format string) func(index int) string {
+	return func(index int) string {
+		street := streets[index%len(streets)]
+		city := cities[(index/len(streets))%len(cities)]
+		return fmt.Sprintf(format, index+1, street, city)
+	}
+}
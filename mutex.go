@@ -0,0 +1,74 @@
+package ggda
+
+import (
+	"reflect"
+	"slices"
+)
+
+// SetMutexGroup makes exactly one of fields populated per item, modelling a discriminated union
+// The populated field cycles by index, or is drawn from the seed when one is set;
+// the others are set to their zero value after filling, before dependents run
+// A field that is already non-zero before filling, as with Fill or GenerateFunc, is kept as the chosen one
+func (g *Generator[T]) SetMutexGroup(fields ...string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(fields) > 0 {
+		g.mutexGroups = append(g.mutexGroups, slices.Clone(fields))
+	}
+	return g
+}
+
+// inMutexGroup reports whether path belongs to one of the mutex groups
+func (g *Generator[T]) inMutexGroup(path string) bool {
+	for _, group := range g.mutexGroups {
+		if slices.Contains(group, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// presetMembers returns, per mutex group, the position of the first field already non-zero in v, or -1
+// Fields behind a nil pointer are not set yet and are skipped
+func (g *Generator[T]) presetMembers(v reflect.Value) []int {
+	if len(g.mutexGroups) == 0 {
+		return nil
+	}
+	preset := make([]int, len(g.mutexGroups))
+	for i, group := range g.mutexGroups {
+		preset[i] = -1
+		for j, path := range group {
+			if field, err := fieldByPath(v, path); err == nil && !field.IsZero() {
+				preset[i] = j
+				break
+			}
+		}
+	}
+	return preset
+}
+
+// applyMutexGroups zeroes every field of each group except the one chosen for the index
+// or the one preset before filling
+func (g *Generator[T]) applyMutexGroups(v reflect.Value, index int, preset []int) error {
+	for n, group := range g.mutexGroups {
+		keep := index % len(group)
+		if g.rand != nil {
+			keep = g.rand.Intn(len(group))
+		}
+		if preset[n] >= 0 {
+			keep = preset[n]
+		}
+		for i, path := range group {
+			if i == keep {
+				continue
+			}
+			field, err := fieldByPath(v, path)
+			if err != nil {
+				return err
+			}
+			field.Set(reflect.Zero(field.Type()))
+			delete(g.populated, path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package ggda
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+)
+
+var (
+	ipType    = reflect.TypeOf(net.IP(nil))
+	netipType = reflect.TypeOf(netip.Addr{})
+	urlType   = reflect.TypeOf(url.URL{})
+)
+
+// urlFormat is the format of generated URLs
+const urlFormat = "https://example.com/%d"
+
+// fillNetwork sets net.IP, netip.Addr and url.URL fields, reporting whether field was one of them
+// Addresses count up from 10.0.0.1 by index, and URLs look like https://example.com/1
+// Pointers such as *url.URL reach here through the pointer case of autoFill
+func (g *Generator[T]) fillNetwork(field reflect.Value, index int) bool {
+	switch field.Type() {
+	case ipType:
+		field.Set(reflect.ValueOf(g.ipValue(index)))
+	case netipType:
+		addr, _ := netip.AddrFromSlice(g.ipValue(index))
+		field.Set(reflect.ValueOf(addr))
+	case urlType:
+		u, _ := url.Parse(fmt.Sprintf(urlFormat, g.suffixValue(index)))
+		field.Set(reflect.ValueOf(*u))
+	default:
+		return false
+	}
+	return true
+}
+
+// ipValue returns an IPv4 address in 10.0.0.0/8
+func (g *Generator[T]) ipValue(index int) net.IP {
+	n := g.suffixValue(index)
+	return net.IPv4(10, byte(n>>16), byte(n>>8), byte(n)).To4()
+}
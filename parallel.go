@@ -0,0 +1,78 @@
+package ggda
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// GenerateParallel creates a slice of structs using several goroutines
+// The index range is split into contiguous chunks so output order matches Generate
+// workers <= 0 uses GOMAXPROCS; with a seed each chunk draws from its own source
+// It panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateParallel(count, workers int) []T {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > count {
+		workers = count
+	}
+	result := make([]T, count)
+	if count == 0 {
+		return result
+	}
+
+	// workers use copies of the configuration, so g stays usable from callbacks
+	g.mu.Lock()
+	if err := g.checkPaths(); err != nil {
+		g.mu.Unlock()
+		panic(err)
+	}
+	chunk := (count + workers - 1) / workers
+	forks := make([]*Generator[T], 0, workers)
+	for start := 0; start < count; start += chunk {
+		forks = append(forks, g.fork(int64(start)))
+	}
+	startIndex := g.startIndex
+	g.mu.Unlock()
+
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w, worker := range forks {
+		start := w * chunk
+		end := min(start+chunk, count)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				elem, err := worker.generateSingle(i)
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				result[i] = elem
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			panic(err)
+		}
+	}
+	g.mu.Lock()
+	g.nextIndex = max(g.nextIndex, startIndex+count)
+	g.mu.Unlock()
+	return result
+}
+
+// fork returns a copy of g's configuration with its own random source
+// The caller must hold g.mu
+func (g *Generator[T]) fork(offset int64) *Generator[T] {
+	w := g.cloneLocked()
+	if g.rand != nil {
+		w.rand = rand.New(rand.NewSource(g.seed + offset))
+	}
+	return w
+}
@@ -0,0 +1,155 @@
+package ggda
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Validate checks that every field name passed to SetCustom, SetCustomCtx, SetDefaults,
+// SetSliceLen, SetMapLen, SetBoolRate, SetNilRate, SetTemplate, SetDictionary, SetForeignKey,
+// SetSequence, SetMutexGroup, SetTimeOrder, SetDependent and Derive exists on T
+// Unknown names are otherwise ignored during generation
+func (g *Generator[T]) Validate() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	var errs []error
+	for _, path := range g.configuredPaths() {
+		if _, err := resolvePath(t, path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// configuredPaths returns the sorted field paths that have configuration
+func (g *Generator[T]) configuredPaths() []string {
+	set := make(map[string]bool)
+	for path := range g.customs {
+		set[path] = true
+	}
+	for path := range g.ctxCustoms {
+		set[path] = true
+	}
+	for path := range g.defaults {
+		set[path] = true
+	}
+	for path := range g.sliceLens {
+		set[path] = true
+	}
+	for path := range g.mapLens {
+		set[path] = true
+	}
+	for path := range g.boolRates {
+		set[path] = true
+	}
+	for path := range g.templates {
+		set[path] = true
+	}
+	for path := range g.dictionaries {
+		set[path] = true
+	}
+	for path := range g.sequences {
+		set[path] = true
+	}
+	for path := range g.nilRates {
+		set[path] = true
+	}
+	for path := range g.foreignKeys {
+		set[path] = true
+	}
+	for _, group := range g.mutexGroups {
+		for _, path := range group {
+			set[path] = true
+		}
+	}
+	for _, o := range g.timeOrders {
+		set[o.earlier] = true
+		set[o.later] = true
+	}
+	for _, d := range g.dependents {
+		set[d.field] = true
+	}
+	paths := make([]string, 0, len(set))
+	for path := range set {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// validatePaths checks that every dotted path in customs and defaults resolves to a field of T
+func (g *Generator[T]) validatePaths() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.checkPaths()
+}
+
+// checkPaths is validatePaths with the lock held
+func (g *Generator[T]) checkPaths() error {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for path := range g.customs {
+		if err := checkDottedPath(t, path); err != nil {
+			return err
+		}
+	}
+	for path := range g.ctxCustoms {
+		if err := checkDottedPath(t, path); err != nil {
+			return err
+		}
+	}
+	for path := range g.defaults {
+		if err := checkDottedPath(t, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDottedPath resolves path only when it addresses a nested field
+func checkDottedPath(t reflect.Type, path string) error {
+	if !strings.Contains(path, ".") {
+		return nil
+	}
+	_, err := resolvePath(t, path)
+	return err
+}
+
+// resolvePath returns the struct field addressed by a dotted path
+// Pointer, slice, array and map types are looked through to their element type
+func resolvePath(t reflect.Type, path string) (reflect.StructField, error) {
+	var field reflect.StructField
+	segments := strings.Split(path, ".")
+	for i, name := range segments {
+		t = elemType(t)
+		if t.Kind() != reflect.Struct {
+			return field, fmt.Errorf("ggda: path %q: %s is not a struct", path, strings.Join(segments[:i], "."))
+		}
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return field, fmt.Errorf("ggda: path %q: %s has no field %s", path, t, name)
+		}
+		if f.Anonymous && i < len(segments)-1 && isPlainStruct(elemType(f.Type)) {
+			return field, fmt.Errorf("ggda: path %q: %s is embedded, use the promoted field name", path, name)
+		}
+		field = f
+		t = f.Type
+	}
+	return field, nil
+}
+
+// elemType unwraps pointer and container types to the type of the values they hold
+func elemType(t reflect.Type) reflect.Type {
+	for {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		default:
+			return t
+		}
+	}
+}
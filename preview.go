@@ -0,0 +1,40 @@
+package ggda
+
+import (
+	"reflect"
+)
+
+// Preview returns the values fieldName would get at indexes 0 to count-1
+// It generates from a clone with copies of its sequences, so the receiver's random sequence
+// and sequences are left untouched; a seeded clone restarts from the seed, so its values match
+// what a fresh generator with the same seed would produce rather than the receiver's next Generate
+func (g *Generator[T]) Preview(fieldName string, count int) ([]interface{}, error) {
+	c := g.Clone()
+	// copy each sequence once so fields sharing one still share its copy
+	copies := make(map[*Sequence]*Sequence)
+	for path, seq := range c.sequences {
+		if copies[seq] == nil {
+			copies[seq] = seq.clone()
+		}
+		c.sequences[path] = copies[seq]
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := resolvePath(reflect.TypeOf((*T)(nil)).Elem(), fieldName); err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		elem, err := c.generateSingle(i)
+		if err != nil {
+			return nil, err
+		}
+		field, err := fieldByPath(reflect.ValueOf(&elem).Elem(), fieldName)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, field.Interface())
+	}
+	return values, nil
+}
@@ -0,0 +1,41 @@
+package ggda
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPreviewLeavesSequencesUntouched(t *testing.T) {
+	type item struct {
+		ID int
+	}
+	g := New[item]().SetSequence("ID", NewSequence(1))
+	preview, err := g.Preview("ID", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []interface{}{1, 2, 3}; !reflect.DeepEqual(preview, want) {
+		t.Errorf("Preview = %v; want %v", preview, want)
+	}
+	if items := g.Generate(2); items[0].ID != 1 || items[1].ID != 2 {
+		t.Errorf("IDs after Preview = %d, %d; want 1, 2", items[0].ID, items[1].ID)
+	}
+}
+
+func TestPreviewSeededAfterGenerate(t *testing.T) {
+	type item struct {
+		Score int
+	}
+	g := New[item]().WithSeed(7)
+	g.Generate(3)
+	preview, err := g.Preview("Score", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fresh := New[item]().WithSeed(7).Generate(3)
+	for i, v := range preview {
+		if v != fresh[i].Score {
+			t.Errorf("Preview[%d] = %v; want %d from a fresh generator", i, v, fresh[i].Score)
+		}
+	}
+}
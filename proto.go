@@ -0,0 +1,38 @@
+package ggda
+
+import (
+	"reflect"
+	"strings"
+)
+
+// protoInternalPkgs are the protobuf packages whose types only appear in message bookkeeping
+// Well-known types like wrapperspb and timestamppb live elsewhere and are filled as usual
+var protoInternalPkgs = []string{
+	"google.golang.org/protobuf/runtime/protoimpl",
+	"google.golang.org/protobuf/internal/",
+}
+
+// ProtoSafe skips the internal fields of protobuf generated messages
+// Besides the unexported state, sizeCache and unknownFields, which are skipped like any unexported field,
+// this leaves out legacy XXX_ fields and fields whose type comes from the protobuf internals
+// Optional scalars like *int32 and well-known types like *wrapperspb.StringValue are filled as usual
+func (g *Generator[T]) ProtoSafe(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.protoSafe = enabled
+	return g
+}
+
+// isProtoInternal reports whether f is bookkeeping added by protoc-gen-go
+func isProtoInternal(f reflect.StructField) bool {
+	if strings.HasPrefix(f.Name, "XXX_") {
+		return true
+	}
+	pkg := elemType(f.Type).PkgPath()
+	for _, internal := range protoInternalPkgs {
+		if strings.HasPrefix(pkg, internal) {
+			return true
+		}
+	}
+	return false
+}
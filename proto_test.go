@@ -0,0 +1,34 @@
+package ggda
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProtoSafeFillsWellKnownTypes(t *testing.T) {
+	type message struct {
+		Name      *wrapperspb.StringValue
+		Count     *wrapperspb.Int64Value
+		CreatedAt *timestamppb.Timestamp
+		Nickname  *string
+		XXX_Extra []byte
+	}
+	v := New[message]().ProtoSafe(true).GenerateOne()
+	if v.Name == nil || v.Name.GetValue() == "" {
+		t.Errorf("Name = %v; want a filled wrapper", v.Name)
+	}
+	if v.Count == nil || v.Count.GetValue() == 0 {
+		t.Errorf("Count = %v; want a filled wrapper", v.Count)
+	}
+	if v.CreatedAt == nil || v.CreatedAt.GetSeconds() == 0 {
+		t.Errorf("CreatedAt = %v; want a filled timestamp", v.CreatedAt)
+	}
+	if v.Nickname == nil {
+		t.Error("Nickname is nil; want an optional scalar")
+	}
+	if v.XXX_Extra != nil {
+		t.Errorf("XXX_Extra = %v; want it skipped", v.XXX_Extra)
+	}
+}
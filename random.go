@@ -0,0 +1,205 @@
+package ggda
+
+import (
+	"math"
+	"math/rand"
+)
+
+// maxRandomSuffix bounds the random number appended to generated strings
+const maxRandomSuffix = 1000000
+
+// WithSeed makes autoFill produce pseudo-random values from the given seed
+// Generators created with the same seed produce the same data
+// Without a seed, values are derived from the index
+func (g *Generator[T]) WithSeed(seed int64) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.seed = seed
+	g.rand = rand.New(rand.NewSource(seed))
+	return g
+}
+
+// GenerateShuffled generates count structs and shuffles them with a source seeded with seed
+// The shuffle seed is independent of WithSeed, so order and values can be varied separately
+// It panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateShuffled(count int, seed int64) []T {
+	result := g.Generate(count)
+	r := rand.New(rand.NewSource(seed))
+	r.Shuffle(len(result), func(i, j int) {
+		result[i], result[j] = result[j], result[i]
+	})
+	return result
+}
+
+// suffixValue returns the number appended to generated strings
+func (g *Generator[T]) suffixValue(index int) int {
+	if g.rand == nil {
+		return index + 1
+	}
+	return g.rand.Intn(maxRandomSuffix) + 1
+}
+
+// SetIntStrategy replaces the index+1 formula for signed integer fields, e.g. to use a stride
+// It applies to fields without a custom, default or both bounds, and wins over the seed
+func (g *Generator[T]) SetIntStrategy(fn func(index int) int64) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.intStrategy = fn
+	return g
+}
+
+// SetUintStrategy is like SetIntStrategy for unsigned integer fields
+func (g *Generator[T]) SetUintStrategy(fn func(index int) uint64) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.uintStrategy = fn
+	return g
+}
+
+// SetFloatStrategy is like SetIntStrategy for float fields
+func (g *Generator[T]) SetFloatStrategy(fn func(index int) float64) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.floatStrategy = fn
+	return g
+}
+
+// intValue returns a positive value that fits in a signed int of the given bit size
+func (g *Generator[T]) intValue(index int, bits int) int64 {
+	if fn := g.intStrategy; fn != nil {
+		var v int64
+		g.unlocked(func() { v = fn(index) })
+		return v
+	}
+	if g.rand == nil {
+		return int64(index + 1)
+	}
+	return g.rand.Int63n(math.MaxInt64>>(64-bits)) + 1
+}
+
+// uintValue returns a positive value that fits in an unsigned int of the given bit size
+func (g *Generator[T]) uintValue(index int, bits int) uint64 {
+	if fn := g.uintStrategy; fn != nil {
+		var v uint64
+		g.unlocked(func() { v = fn(index) })
+		return v
+	}
+	if g.rand == nil {
+		return uint64(index + 1)
+	}
+	return uint64(g.rand.Int63n(math.MaxInt64>>(64-bits)) + 1)
+}
+
+// floatValue returns the value used for float fields
+func (g *Generator[T]) floatValue(index int) float64 {
+	if fn := g.floatStrategy; fn != nil {
+		var v float64
+		g.unlocked(func() { v = fn(index) })
+		return v
+	}
+	if g.rand == nil {
+		return float64(index+1) * 1.1
+	}
+	return g.rand.Float64() * 1000
+}
+
+// complexValue returns the value used for complex fields
+func (g *Generator[T]) complexValue(index int) complex128 {
+	if g.rand == nil {
+		return complex(float64(index+1), float64(index+1))
+	}
+	return complex(g.rand.Float64()*1000, g.rand.Float64()*1000)
+}
+
+// boolValue returns the value used for bool fields
+func (g *Generator[T]) boolValue(index int) bool {
+	if g.rand == nil {
+		return index%2 == 0
+	}
+	return g.rand.Intn(2) == 0
+}
+
+// SetBoolRate makes a bool field true with probability p, clamped to [0, 1]
+// Without a seed the trues are spread evenly so exactly p of any run of indexes are true
+// The same can be set with a tag like `ggda:"truerate=0.8"`
+func (g *Generator[T]) SetBoolRate(fieldName string, p float64) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.boolRates[fieldName] = min(max(p, 0), 1)
+	return g
+}
+
+// SetNilRate makes a pointer field nil with probability p, clamped to [0, 1], and filled otherwise
+// For sql.Null* fields it is the share of values left null
+// Like SetBoolRate the nils are spread evenly without a seed and drawn from the seed with one
+func (g *Generator[T]) SetNilRate(fieldName string, p float64) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.nilRates[fieldName] = min(max(p, 0), 1)
+	return g
+}
+
+// TriStateBools makes *bool fields cycle through nil, false and true by index
+// so every state appears in a batch; with a seed the state is drawn at random
+// Fields with a SetNilRate keep using the rate
+func (g *Generator[T]) TriStateBools(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.triStateBools = enabled
+	return g
+}
+
+// triStateBool returns nil, false or true for the index
+func (g *Generator[T]) triStateBool(index int) *bool {
+	state := index % 3
+	if g.rand != nil {
+		state = g.rand.Intn(3)
+	}
+	if state == 0 {
+		return nil
+	}
+	b := state == 2
+	return &b
+}
+
+// boolWithRate returns true with probability p
+func (g *Generator[T]) boolWithRate(index int, p float64) bool {
+	if g.rand != nil {
+		return g.rand.Float64() < p
+	}
+	// true whenever the running count of expected trues crosses an integer
+	return math.Floor(float64(index+1)*p) > math.Floor(float64(index)*p)
+}
+
+// intInRange returns a value in [lo, hi], cycling by index or drawn from the seed
+func (g *Generator[T]) intInRange(index int, lo, hi int64) int64 {
+	span := hi - lo + 1
+	if span <= 0 {
+		// the range covers every int64
+		return g.intValue(index, 64)
+	}
+	if g.rand == nil {
+		return lo + int64(index)%span
+	}
+	return lo + g.rand.Int63n(span)
+}
+
+// floatInRange returns a value in [lo, hi]
+func (g *Generator[T]) floatInRange(index int, lo, hi float64) float64 {
+	if hi == lo {
+		return lo
+	}
+	if g.rand == nil {
+		return lo + math.Mod(float64(index+1)*1.1, hi-lo)
+	}
+	return lo + g.rand.Float64()*(hi-lo)
+}
+
+// mix64 scrambles x into a well distributed value (splitmix64 finalizer)
+// It gives stateless pseudo-random values that are safe to compute concurrently
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
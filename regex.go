@@ -0,0 +1,141 @@
+package ggda
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// maxRegexRepeat is how many extra repetitions unbounded operators like * and + produce at most
+const maxRegexRepeat = 5
+
+// regexCache holds parsed regex tag values
+var regexCache sync.Map
+
+// regexString generates a string matching pattern
+// Choices are drawn from the seed when one is set, otherwise they depend only on index
+func (g *Generator[T]) regexString(fieldName, pattern string, index int) (string, error) {
+	re, err := parseRegex(pattern)
+	if err != nil {
+		return "", fmt.Errorf("ggda: field %s: invalid regex %q: %w", fieldName, pattern, err)
+	}
+	rg := regexGen{rand: g.rand, state: uint64(index)}
+	if err := rg.gen(re); err != nil {
+		return "", fmt.Errorf("ggda: field %s: regex %q: %w", fieldName, pattern, err)
+	}
+	return rg.sb.String(), nil
+}
+
+// parseRegex parses pattern with Perl syntax, caching the result
+func parseRegex(pattern string) (*syntax.Regexp, error) {
+	if re, ok := regexCache.Load(pattern); ok {
+		return re.(*syntax.Regexp), nil
+	}
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	regexCache.Store(pattern, re)
+	return re, nil
+}
+
+// regexGen builds a string that matches a parsed regex
+type regexGen struct {
+	rand  *rand.Rand
+	state uint64
+	sb    strings.Builder
+}
+
+// intn returns a choice in [0, n)
+func (r *regexGen) intn(n int) int {
+	if r.rand != nil {
+		return r.rand.Intn(n)
+	}
+	r.state = mix64(r.state)
+	return int(r.state % uint64(n))
+}
+
+func (r *regexGen) gen(re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText:
+		// anchors match without consuming characters
+	case syntax.OpLiteral:
+		r.sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		r.sb.WriteRune(r.classRune(re.Rune))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		r.sb.WriteRune(rune(' ' + r.intn('~'-' '+1)))
+	case syntax.OpCapture:
+		return r.gen(re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := r.gen(sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		return r.gen(re.Sub[r.intn(len(re.Sub))])
+	case syntax.OpStar:
+		return r.repeat(re.Sub[0], 0, -1)
+	case syntax.OpPlus:
+		return r.repeat(re.Sub[0], 1, -1)
+	case syntax.OpQuest:
+		return r.repeat(re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		return r.repeat(re.Sub[0], re.Min, re.Max)
+	default:
+		return fmt.Errorf("unsupported regex feature %s", re)
+	}
+	return nil
+}
+
+// repeat generates sub between lo and hi times; hi < 0 means unbounded
+func (r *regexGen) repeat(sub *syntax.Regexp, lo, hi int) error {
+	if hi < 0 {
+		hi = lo + maxRegexRepeat
+	}
+	n := lo + r.intn(hi-lo+1)
+	for i := 0; i < n; i++ {
+		if err := r.gen(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// classRune picks a rune from a character class given as pairs of ranges
+// Printable ASCII is preferred so negated classes stay readable
+func (r *regexGen) classRune(ranges []rune) rune {
+	printable := clipRanges(ranges, ' ', '~')
+	if len(printable) > 0 {
+		ranges = printable
+	}
+	total := 0
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	n := r.intn(total)
+	for i := 0; i < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n)
+		}
+		n -= size
+	}
+	return unicode.ReplacementChar
+}
+
+// clipRanges intersects pairs of rune ranges with [lo, hi]
+func clipRanges(ranges []rune, lo, hi rune) []rune {
+	var clipped []rune
+	for i := 0; i < len(ranges); i += 2 {
+		a, b := max(ranges[i], lo), min(ranges[i+1], hi)
+		if a <= b {
+			clipped = append(clipped, a, b)
+		}
+	}
+	return clipped
+}
@@ -0,0 +1,51 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// SetForeignKey makes an integer field reference keys from pool, e.g. the IDs of generated users
+// Keys are assigned round-robin by index, or drawn from the seed when one is set
+// Round-robin keys are distinct as long as count does not exceed len(pool), so a field with a
+// unique constraint needs a pool at least that large and no seed; random keys may repeat
+// An empty pool removes the foreign key
+func (g *Generator[T]) SetForeignKey(fieldName string, pool []int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(pool) == 0 {
+		delete(g.foreignKeys, fieldName)
+		return g
+	}
+	g.foreignKeys[fieldName] = slices.Clone(pool)
+	return g
+}
+
+// fillForeignKey sets field to a key from its pool
+func (g *Generator[T]) fillForeignKey(field reflect.Value, path string, pool []int, index int) error {
+	key := pool[index%len(pool)]
+	if g.rand != nil {
+		key = pool[g.rand.Intn(len(pool))]
+	}
+	return setInteger(field, path, "foreign key", int64(key))
+}
+
+// setInteger sets an integer field of any kind to n; what names n in errors
+func setInteger(field reflect.Value, path, what string, n int64) error {
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if field.OverflowInt(n) {
+			return fmt.Errorf("ggda: field %s: %s %d overflows %s", path, what, n, field.Type())
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n < 0 || field.OverflowUint(uint64(n)) {
+			return fmt.Errorf("ggda: field %s: %s %d overflows %s", path, what, n, field.Type())
+		}
+		field.SetUint(uint64(n))
+	default:
+		return fmt.Errorf("ggda: field %s: %s needs an integer field, got %s", path, what, field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package ggda
+
+import (
+	"sync/atomic"
+)
+
+// Sequence hands out increasing int64 values and is safe for concurrent use
+// The zero value starts at 1
+type Sequence struct {
+	last atomic.Int64
+}
+
+// NewSequence returns a sequence whose first value is start
+func NewSequence(start int64) *Sequence {
+	s := &Sequence{}
+	s.last.Store(start - 1)
+	return s
+}
+
+// Next returns the next value of the sequence
+func (s *Sequence) Next() int64 {
+	return s.last.Add(1)
+}
+
+// clone returns a sequence that continues from the current value of s without advancing it
+func (s *Sequence) clone() *Sequence {
+	c := &Sequence{}
+	c.last.Store(s.last.Load())
+	return c
+}
+
+// SetSequence makes an integer field draw each value from seq
+// seq can be shared by several fields, generators or GenerateParallel workers
+// and every value is still unique; values grow in the order fields are filled
+func (g *Generator[T]) SetSequence(fieldName string, seq *Sequence) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.sequences[fieldName] = seq
+	return g
+}
@@ -0,0 +1,79 @@
+package ggda
+
+import (
+	"reflect"
+)
+
+// GenerateUpToBytes generates structs until the next one would take the estimated
+// in-memory size past maxBytes; len of the result is the count that fit
+// Sizes include the struct itself and the strings, slices, maps and pointers it references
+// It panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateUpToBytes(maxBytes int) []T {
+	if err := g.validatePaths(); err != nil {
+		panic(err)
+	}
+	var result []T
+	total := 0
+	for i := 0; ; i++ {
+		elem, err := g.generateSingle(i)
+		if err != nil {
+			panic(err)
+		}
+		// count empty structs as a byte so the loop ends
+		size := max(estimateSize(reflect.ValueOf(elem)), 1)
+		if total+size > maxBytes {
+			return result
+		}
+		total += size
+		result = append(result, elem)
+	}
+}
+
+// estimateSize returns the approximate number of bytes v occupies, including what it references
+func estimateSize(v reflect.Value) int {
+	return int(v.Type().Size()) + referencedSize(v)
+}
+
+// referencedSize returns the approximate number of bytes v references outside its own storage
+func referencedSize(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.String:
+		return v.Len()
+	case reflect.Ptr:
+		if v.IsNil() {
+			return 0
+		}
+		return estimateSize(v.Elem())
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0
+		}
+		return estimateSize(v.Elem())
+	case reflect.Slice:
+		n := v.Cap() * int(v.Type().Elem().Size())
+		for i := 0; i < v.Len(); i++ {
+			n += referencedSize(v.Index(i))
+		}
+		return n
+	case reflect.Array:
+		n := 0
+		for i := 0; i < v.Len(); i++ {
+			n += referencedSize(v.Index(i))
+		}
+		return n
+	case reflect.Map:
+		n := 0
+		iter := v.MapRange()
+		for iter.Next() {
+			n += estimateSize(iter.Key()) + estimateSize(iter.Value())
+		}
+		return n
+	case reflect.Struct:
+		n := 0
+		for i := 0; i < v.NumField(); i++ {
+			n += referencedSize(v.Field(i))
+		}
+		return n
+	}
+	return 0
+}
@@ -0,0 +1,76 @@
+package ggda
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ExcludeSQLColumns leaves the named fields out of GenerateSQL, e.g. auto-increment keys
+func (g *Generator[T]) ExcludeSQLColumns(fieldNames ...string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, name := range fieldNames {
+		g.sqlExclude[name] = true
+	}
+	return g
+}
+
+// GenerateSQL generates count structs as one parameterized multi-row INSERT statement
+// Column names come from the db struct tag when present, otherwise from the json tag or field name,
+// and fields tagged `db:"-"` are left out; placeholders use the "?" style
+// A count below one returns an empty statement and no args;
+// it panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateSQL(table string, count int) (string, []interface{}) {
+	if count <= 0 {
+		return "", nil
+	}
+	result := g.Generate(count)
+
+	g.mu.Lock()
+	var fields []reflect.StructField
+	var columns []string
+	for _, f := range exportedFields(reflect.TypeOf((*T)(nil)).Elem()) {
+		column := sqlColumn(f)
+		if column == "" || g.sqlExclude[f.Name] {
+			continue
+		}
+		fields = append(fields, f)
+		columns = append(columns, column)
+	}
+	g.mu.Unlock()
+
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO ")
+	sb.WriteString(table)
+	sb.WriteString(" (")
+	sb.WriteString(strings.Join(columns, ", "))
+	sb.WriteString(") VALUES ")
+
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(fields)), ", ") + ")"
+	args := make([]interface{}, 0, len(fields)*count)
+	for i, elem := range result {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(placeholders)
+		v := reflect.ValueOf(elem)
+		for _, f := range fields {
+			args = append(args, v.FieldByIndex(f.Index).Interface())
+		}
+	}
+	return sb.String(), args
+}
+
+// sqlColumn returns the column name of a field, or "" if it is left out
+// The db tag wins over the json tag, which wins over the field name
+func sqlColumn(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("db"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		name, _, _ := externalName(f)
+		return name
+	}
+	return name
+}
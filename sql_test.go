@@ -0,0 +1,19 @@
+package ggda
+
+import "testing"
+
+func TestGenerateSQLWithoutRows(t *testing.T) {
+	type item struct {
+		ID   int
+		Name string
+	}
+	g := New[item]()
+	for _, count := range []int{0, -1} {
+		if query, args := g.GenerateSQL("items", count); query != "" || args != nil {
+			t.Errorf("GenerateSQL(%d) = %q, %v; want empty", count, query, args)
+		}
+	}
+	if query, args := g.GenerateSQL("items", 2); query != "INSERT INTO items (ID, Name) VALUES (?, ?), (?, ?)" || len(args) != 4 {
+		t.Errorf("GenerateSQL(2) = %q, %v", query, args)
+	}
+}
@@ -0,0 +1,39 @@
+package ggda
+
+import (
+	"reflect"
+	"strings"
+)
+
+// sqlPkgPath is the import path of database/sql
+const sqlPkgPath = "database/sql"
+
+// isSQLNull reports whether t is one of the database/sql null wrappers like sql.NullString or sql.Null[T]
+// They hold a Valid bool next to the value field
+func isSQLNull(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || t.PkgPath() != sqlPkgPath || !strings.HasPrefix(t.Name(), "Null") || t.NumField() != 2 {
+		return false
+	}
+	valid, ok := t.FieldByName("Valid")
+	return ok && valid.Type.Kind() == reflect.Bool
+}
+
+// fillSQLNull fills the value of a sql null wrapper and marks it valid
+// A rate set with SetNilRate leaves that share of values null instead
+func (g *Generator[T]) fillSQLNull(field reflect.Value, fieldType reflect.StructField, path string, index int, depth int) error {
+	if p, ok := g.nilRates[path]; ok && g.boolWithRate(index, p) {
+		return nil
+	}
+	t := field.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Name == "Valid" {
+			continue
+		}
+		// the value field keeps the outer field's tag so options like oneof still apply
+		if err := g.autoFill(field.Field(i), fieldType, path, index, depth); err != nil {
+			return err
+		}
+	}
+	field.FieldByName("Valid").SetBool(true)
+	return nil
+}
@@ -0,0 +1,162 @@
+package ggda
+
+import (
+	"reflect"
+)
+
+// GenerateWithStats generates count structs and counts how often each field value occurs
+// Stats are keyed by field path, with nested struct fields as dotted paths like "Address.City"
+// and embedded struct fields under their promoted names
+// Pointers are counted by the value they point to, with nil for nil pointers;
+// fields whose values are not comparable, such as slices and maps, are left out
+// It panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateWithStats(count int) ([]T, map[string]map[interface{}]int) {
+	result := g.Generate(count)
+	stats := make(map[string]map[interface{}]int)
+	for i := range result {
+		countFields(stats, reflect.ValueOf(result[i]), "")
+	}
+	return result, stats
+}
+
+// countFields adds the exported field values of the struct v to stats
+func countFields(stats map[string]map[interface{}]int, v reflect.Value, prefix string) {
+	for _, f := range exportedFields(v.Type()) {
+		path := prefix + f.Name
+		field := v.FieldByIndex(f.Index)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				addStat(stats, path, nil)
+				continue
+			}
+			field = field.Elem()
+		}
+		if isPlainStruct(field.Type()) && field.Type() != netipType {
+			// embedded fields are counted under their promoted names
+			if f.Anonymous {
+				countFields(stats, field, prefix)
+			} else {
+				countFields(stats, field, nestedPrefix(path))
+			}
+			continue
+		}
+		if field.Kind() == reflect.Interface && !field.IsNil() {
+			field = field.Elem()
+		}
+		if !field.IsValid() || !field.Comparable() {
+			continue
+		}
+		addStat(stats, path, field.Interface())
+	}
+}
+
+// GenerateCounted generates count structs and reports how many of them had each field populated
+// A field counts when generation sets it, even to a zero value like false, or when it already
+// held a value; fields left zero by Exclude, LimitFields, the sometimes tag or a mutex group,
+// and unsupported types count as not populated
+// Every exported field is listed, so a field that is never populated shows up with 0
+// Paths follow GenerateWithStats; time.Time, url.URL and other types with their own handling count as one field
+// With SetFastPath nothing is tracked, so non-zero fields count as populated
+// It panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) GenerateCounted(count int) ([]T, map[string]int) {
+	if err := g.validatePaths(); err != nil {
+		panic(err)
+	}
+	result := make([]T, count)
+	filled := make(map[string]int)
+	countFilled(filled, reflect.Zero(reflect.TypeOf((*T)(nil)).Elem()), "")
+	for i := range result {
+		elem, populated, err := g.generateTracked(i)
+		if err != nil {
+			panic(err)
+		}
+		result[i] = elem
+		if populated == nil {
+			countFilled(filled, reflect.ValueOf(elem), "")
+			continue
+		}
+		for path := range populated {
+			// only listed paths, leaving out struct fields and slice elements
+			if _, ok := filled[path]; ok {
+				filled[path]++
+			}
+		}
+	}
+	return result, filled
+}
+
+// generateTracked generates the struct at index and returns the paths of the fields it populated
+// The paths are nil when a fast path fills the struct
+func (g *Generator[T]) generateTracked(index int) (T, map[string]bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.fastPath == nil {
+		g.populated = make(map[string]bool)
+	}
+	defer func() { g.populated = nil }()
+	elem, err := g.generateLocked(index)
+	return elem, g.populated, err
+}
+
+// markPopulated records that the field at path was populated, when GenerateCounted tracks it
+func (g *Generator[T]) markPopulated(path string) {
+	if g.populated != nil {
+		g.populated[path] = true
+	}
+}
+
+// countFilled adds one to filled for each non-zero exported field of the struct v
+// Zero fields are still listed with their current count; structs are followed the way
+// fillStruct follows them, so embedded fields appear under their promoted names
+func countFilled(filled map[string]int, v reflect.Value, prefix string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		path := prefix + f.Name
+		fv := v.Field(i)
+		if f.Anonymous && isPlainStruct(elemType(f.Type)) && (f.Type.Kind() == reflect.Struct || f.Type.Kind() == reflect.Ptr) {
+			if f.Type.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					fv = reflect.Zero(f.Type.Elem())
+				} else {
+					fv = fv.Elem()
+				}
+			}
+			countFilled(filled, fv, prefix)
+			continue
+		}
+		if walkedStruct(f.Type) {
+			countFilled(filled, fv, nestedPrefix(path))
+			continue
+		}
+		if fv.IsZero() {
+			filled[path] += 0
+		} else {
+			filled[path]++
+		}
+	}
+}
+
+// walkedStruct reports whether fillStruct fills a field of struct type t field by field
+// Types with their own handling, like url.URL, big.Float and sql.NullString, are filled as one value
+func walkedStruct(t reflect.Type) bool {
+	if !isPlainStruct(t) || isSQLNull(t) {
+		return false
+	}
+	switch t {
+	case netipType, urlType, bigIntType, bigFloatType:
+		return false
+	}
+	return !reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// addStat counts one occurrence of value at path
+func addStat(stats map[string]map[interface{}]int, path string, value interface{}) {
+	if stats[path] == nil {
+		stats[path] = make(map[interface{}]int)
+	}
+	stats[path][value]++
+}
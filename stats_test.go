@@ -0,0 +1,58 @@
+package ggda
+
+import (
+	"database/sql"
+	"math/big"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestGenerateCountedCountsZeroValues(t *testing.T) {
+	type address struct {
+		Zip string
+	}
+	type item struct {
+		Admin   bool
+		Level   int    `ggda:"range=0,1"`
+		Nick    string `ggda:"sometimes=0"`
+		A, B    string
+		Address address
+		secret  string
+	}
+	_, filled := New[item]().SetMutexGroup("A", "B").GenerateCounted(4)
+	want := map[string]int{
+		"Admin":       4,
+		"Level":       4,
+		"Nick":        0,
+		"A":           2,
+		"B":           2,
+		"Address.Zip": 4,
+	}
+	if !reflect.DeepEqual(filled, want) {
+		t.Errorf("GenerateCounted = %v; want %v", filled, want)
+	}
+}
+
+type CountedUser struct {
+	Name string
+}
+
+func TestGenerateCountedSingleValueStructs(t *testing.T) {
+	type item struct {
+		*CountedUser
+		U  url.URL
+		BF big.Float
+		NS sql.NullString
+	}
+	_, filled := New[item]().GenerateCounted(3)
+	want := map[string]int{
+		"Name": 3,
+		"U":    3,
+		"BF":   3,
+		"NS":   3,
+	}
+	if !reflect.DeepEqual(filled, want) {
+		t.Errorf("GenerateCounted = %v; want %v", filled, want)
+	}
+}
@@ -0,0 +1,111 @@
+package ggda
+
+import (
+	"context"
+	"iter"
+)
+
+// ctxCheckInterval is how many structs GenerateCtx generates between context checks
+const ctxCheckInterval = 100
+
+// Seq returns an iterator that lazily generates count structs
+// Iteration stops early when the consumer breaks out of the loop
+// It panics if a configured value cannot be assigned to its field
+func (g *Generator[T]) Seq(count int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range g.Seq2(count) {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 is like Seq but also yields the index of each struct
+func (g *Generator[T]) Seq2(count int) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if err := g.validatePaths(); err != nil {
+			panic(err)
+		}
+		for i := 0; i < count; i++ {
+			elem, err := g.generateSingle(i)
+			if err != nil {
+				panic(err)
+			}
+			if !yield(i, elem) {
+				return
+			}
+		}
+	}
+}
+
+// SetChanBuffer sets the buffer size of channels returned by GenerateChan
+func (g *Generator[T]) SetChanBuffer(size int) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.chanBuffer = size
+	return g
+}
+
+// GenerateChan generates count structs on a buffered channel from a new goroutine
+// The channel is closed once count structs are sent or ctx is done
+// A generation error also closes it early; use GenerateChanE to receive the error
+func (g *Generator[T]) GenerateChan(ctx context.Context, count int) <-chan T {
+	if err := g.validatePaths(); err != nil {
+		panic(err)
+	}
+	ch, _ := g.GenerateChanE(ctx, count)
+	return ch
+}
+
+// GenerateChanE is like GenerateChan but reports a generation error on errs
+// errs receives at most one error and is closed after the value channel is closed
+func (g *Generator[T]) GenerateChanE(ctx context.Context, count int) (values <-chan T, errs <-chan error) {
+	g.mu.Lock()
+	ch := make(chan T, g.chanBuffer)
+	g.mu.Unlock()
+	errc := make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(ch)
+		if err := g.validatePaths(); err != nil {
+			errc <- err
+			return
+		}
+		for i := 0; i < count; i++ {
+			elem, err := g.generateSingle(i)
+			if err != nil {
+				errc <- err
+				return
+			}
+			select {
+			case ch <- elem:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, errc
+}
+
+// GenerateCtx creates a slice of structs, checking ctx every ctxCheckInterval items
+// When ctx is done it returns the structs generated so far along with ctx.Err()
+func (g *Generator[T]) GenerateCtx(ctx context.Context, count int) ([]T, error) {
+	if err := g.validatePaths(); err != nil {
+		return nil, err
+	}
+	result := make([]T, 0, count)
+	for i := 0; i < count; i++ {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return result, err
+			}
+		}
+		elem, err := g.generateSingle(i)
+		if err != nil {
+			return result, err
+		}
+		result = append(result, elem)
+	}
+	return result, nil
+}
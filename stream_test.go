@@ -0,0 +1,25 @@
+package ggda
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGenerateChanEReportsErrors(t *testing.T) {
+	type item struct {
+		A int
+	}
+	g := New[item]().SetCustom("A", func(int) interface{} { return "not an int" })
+	values, errs := g.GenerateChanE(context.Background(), 3)
+	for range values {
+		t.Error("got a value; want none")
+	}
+	if err := <-errs; err == nil {
+		t.Error("want an error")
+	}
+
+	values = g.GenerateChan(context.Background(), 3)
+	for range values {
+		t.Error("GenerateChan sent a value; want the channel closed")
+	}
+}
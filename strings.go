@@ -0,0 +1,87 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// StringProvider generates values for string fields
+type StringProvider interface {
+	String(fieldName string, index int) string
+}
+
+// SetStringProvider sets the provider used for string fields instead of the "<name>_<n>" format
+// Templates and faker tags still take precedence
+func (g *Generator[T]) SetStringProvider(p StringProvider) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.stringProvider = p
+	return g
+}
+
+// SetTemplate sets a fmt format string for a string field, like "user-%03d@example.com"
+// The template receives index+1, or a random number when a seed is set
+func (g *Generator[T]) SetTemplate(fieldName, template string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.templates[fieldName] = template
+	return g
+}
+
+// SetDictionary makes a string field pick from values, cycling by index or drawing from the seed
+// Values are used as they are and win over templates and tags, oneof included; an empty list removes the dictionary
+func (g *Generator[T]) SetDictionary(fieldName string, values []string) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(values) == 0 {
+		delete(g.dictionaries, fieldName)
+		return g
+	}
+	g.dictionaries[fieldName] = slices.Clone(values)
+	return g
+}
+
+// dictionaryValue picks the dictionary value for a field, if one is set
+func (g *Generator[T]) dictionaryValue(path string, index int) (string, bool) {
+	values, ok := g.dictionaries[path]
+	if !ok {
+		return "", false
+	}
+	if g.rand != nil {
+		return values[g.rand.Intn(len(values))], true
+	}
+	return values[index%len(values)], true
+}
+
+// stringValue returns the value for a string field
+// A dictionary wins over everything else, then a template over a regex tag, then a faker tag, then the string provider,
+// then the default "<name>_<n>" format; regex output is not resized by len
+func (g *Generator[T]) stringValue(opts tagOptions, fieldType reflect.StructField, path string, index int) (string, error) {
+	if value, ok := g.dictionaryValue(path, index); ok {
+		return value, nil
+	}
+	if pattern, ok := opts["regex"]; ok {
+		if _, hasTemplate := g.templates[path]; !hasTemplate {
+			return g.regexString(path, pattern, index)
+		}
+	}
+	suffix := g.suffixValue(index)
+	var str string
+	if template, ok := g.templates[path]; ok {
+		str = fmt.Sprintf(template, suffix)
+	} else if _, ok := opts["uuid"]; ok && g.randomUUIDs {
+		str = formatUUID(g.uuidValue(path, index))
+	} else if faker, ok := lookupFaker(opts, g.locale); ok {
+		if err := g.checkLocale(path); err != nil {
+			return "", err
+		}
+		str = faker(suffix - 1)
+	} else if p := g.stringProvider; p != nil {
+		g.unlocked(func() { str = p.String(fieldType.Name, suffix-1) })
+	} else {
+		str = fmt.Sprintf("%s_%d", strings.ToLower(fieldType.Name), suffix)
+	}
+	return sizedString(opts, path, str)
+}
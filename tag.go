@@ -0,0 +1,436 @@
+package ggda
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// tagName is the struct tag key read by ggda
+const tagName = "ggda"
+
+// goldenRatio is the fractional part of the golden ratio, used to spread weighted picks
+const goldenRatio = 0.6180339887498949
+
+// maxFloatPrec is the largest number of decimals accepted by the prec option
+const maxFloatPrec = 15
+
+// stringPadding is appended to generated strings that are shorter than requested
+const stringPadding = "x"
+
+// listOptions take comma separated values
+// Parts without "=" that follow them are appended to their value
+var listOptions = map[string]bool{
+	"oneof": true,
+	"range": true,
+}
+
+// restOptions take the rest of the tag as their value, commas included
+var restOptions = map[string]bool{
+	"regex": true,
+}
+
+// tagOptions holds the parsed options of a ggda struct tag
+// Options without a value are stored with an empty value
+type tagOptions map[string]string
+
+// parseTag parses a tag like `ggda:"min=1,max=10"` or `ggda:"oneof=a,b,c"`
+// regex must be the last option since it takes the rest of the tag
+func parseTag(tag string) tagOptions {
+	opts := tagOptions{}
+	if tag == "" {
+		return opts
+	}
+	list := ""
+	parts := strings.Split(tag, ",")
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		key, value, hasValue := strings.Cut(part, "=")
+		if !hasValue && list != "" {
+			opts[list] += "," + part
+			continue
+		}
+		if hasValue && restOptions[key] {
+			opts[key] = strings.Join(append([]string{value}, parts[i+1:]...), ",")
+			break
+		}
+		list = ""
+		if listOptions[key] {
+			list = key
+		}
+		opts[key] = value
+	}
+	return opts
+}
+
+// listOption returns the values of a list option
+func (o tagOptions) listOption(key string) ([]string, bool) {
+	raw, ok := o[key]
+	if !ok {
+		return nil, false
+	}
+	return strings.Split(raw, ","), true
+}
+
+// intOption returns the integer value of the given option
+func (o tagOptions) intOption(fieldName, key string) (int64, bool, error) {
+	raw, ok := o[key]
+	if !ok {
+		return 0, false, nil
+	}
+	n, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("ggda: field %s: invalid %s tag value %q", fieldName, key, raw)
+	}
+	return n, true, nil
+}
+
+// floatOption returns the float value of the given option
+func (o tagOptions) floatOption(fieldName, key string) (float64, bool, error) {
+	raw, ok := o[key]
+	if !ok {
+		return 0, false, nil
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("ggda: field %s: invalid %s tag value %q", fieldName, key, raw)
+	}
+	return f, true, nil
+}
+
+// intBounds returns the min and max options of an integer field
+// A range option like `ggda:"range=-100,100"` sets both bounds at once
+func (o tagOptions) intBounds(fieldName string) (lo, hi int64, hasMin, hasMax bool, err error) {
+	if values, ok := o.listOption("range"); ok {
+		if len(values) != 2 {
+			err = fmt.Errorf("ggda: field %s: range needs a min and a max, got %q", fieldName, o["range"])
+			return
+		}
+		r := tagOptions{"min": values[0], "max": values[1]}
+		return r.intBounds(fieldName)
+	}
+	if lo, hasMin, err = o.intOption(fieldName, "min"); err != nil {
+		return
+	}
+	if hi, hasMax, err = o.intOption(fieldName, "max"); err != nil {
+		return
+	}
+	if hasMin && hasMax && lo > hi {
+		err = fmt.Errorf("ggda: field %s: min %d is greater than max %d", fieldName, lo, hi)
+	}
+	return
+}
+
+// boundedInt returns the value for a signed integer field honoring min and max
+// With both bounds values are distributed across the range, otherwise they are clamped
+// Bounds the field cannot hold are rejected rather than truncated
+func (g *Generator[T]) boundedInt(field reflect.Value, opts tagOptions, fieldName string, index int) (int64, error) {
+	lo, hi, hasMin, hasMax, err := opts.intBounds(fieldName)
+	if err != nil {
+		return 0, err
+	}
+	if hasMin && field.OverflowInt(lo) {
+		return 0, fmt.Errorf("ggda: field %s: min %d overflows %s", fieldName, lo, field.Type())
+	}
+	if hasMax && field.OverflowInt(hi) {
+		return 0, fmt.Errorf("ggda: field %s: max %d overflows %s", fieldName, hi, field.Type())
+	}
+	bits := field.Type().Bits()
+	if hasMin && hasMax {
+		return g.intInRange(index, lo, hi), nil
+	}
+	v := g.intValue(index, bits)
+	if hasMin && v < lo {
+		v = lo
+	}
+	if hasMax && v > hi {
+		v = hi
+	}
+	return v, nil
+}
+
+// boundedUint returns the value for an unsigned integer field honoring min and max
+func (g *Generator[T]) boundedUint(field reflect.Value, opts tagOptions, fieldName string, index int) (uint64, error) {
+	lo, hi, hasMin, hasMax, err := opts.intBounds(fieldName)
+	if err != nil {
+		return 0, err
+	}
+	if (hasMin && lo < 0) || (hasMax && hi < 0) {
+		return 0, fmt.Errorf("ggda: field %s: negative bound on unsigned field", fieldName)
+	}
+	if hasMin && field.OverflowUint(uint64(lo)) {
+		return 0, fmt.Errorf("ggda: field %s: min %d overflows %s", fieldName, lo, field.Type())
+	}
+	if hasMax && field.OverflowUint(uint64(hi)) {
+		return 0, fmt.Errorf("ggda: field %s: max %d overflows %s", fieldName, hi, field.Type())
+	}
+	bits := field.Type().Bits()
+	if hasMin && hasMax {
+		return uint64(g.intInRange(index, lo, hi)), nil
+	}
+	v := g.uintValue(index, bits)
+	if hasMin && v < uint64(lo) {
+		v = uint64(lo)
+	}
+	if hasMax && v > uint64(hi) {
+		v = uint64(hi)
+	}
+	return v, nil
+}
+
+// floatBounds returns the min and max options of a float field
+// Like intBounds it accepts a range option such as `ggda:"range=0.0,100.0"`
+func (o tagOptions) floatBounds(fieldName string) (lo, hi float64, hasMin, hasMax bool, err error) {
+	if values, ok := o.listOption("range"); ok {
+		if len(values) != 2 {
+			err = fmt.Errorf("ggda: field %s: range needs a min and a max, got %q", fieldName, o["range"])
+			return
+		}
+		r := tagOptions{"min": values[0], "max": values[1]}
+		return r.floatBounds(fieldName)
+	}
+	if lo, hasMin, err = o.floatOption(fieldName, "min"); err != nil {
+		return
+	}
+	if hi, hasMax, err = o.floatOption(fieldName, "max"); err != nil {
+		return
+	}
+	if hasMin && hasMax && lo > hi {
+		err = fmt.Errorf("ggda: field %s: min %g is greater than max %g", fieldName, lo, hi)
+	}
+	return
+}
+
+// boundedFloat returns the value for a float field honoring min, max and range
+// The prec option rounds the value to that many decimals, e.g. `ggda:"prec=2"`
+func (g *Generator[T]) boundedFloat(opts tagOptions, fieldName string, index int) (float64, error) {
+	lo, hi, hasMin, hasMax, err := opts.floatBounds(fieldName)
+	if err != nil {
+		return 0, err
+	}
+	var v float64
+	if hasMin && hasMax {
+		v = g.floatInRange(index, lo, hi)
+	} else {
+		v = g.floatValue(index)
+	}
+	prec, hasPrec, err := opts.intOption(fieldName, "prec")
+	if err != nil {
+		return 0, err
+	}
+	if hasPrec {
+		if prec < 0 || prec > maxFloatPrec {
+			return 0, fmt.Errorf("ggda: field %s: prec %d is outside [0, %d]", fieldName, prec, maxFloatPrec)
+		}
+		scale := math.Pow10(int(prec))
+		v = math.Round(v*scale) / scale
+	}
+	if hasMin && v < lo {
+		v = lo
+	}
+	if hasMax && v > hi {
+		v = hi
+	}
+	return v, nil
+}
+
+// defaultSometimesRate is the share of items that populate a field tagged `ggda:"sometimes"`
+const defaultSometimesRate = 0.5
+
+// skipSometimes reports whether a field tagged `ggda:"sometimes"` stays zero at index
+// The populated share defaults to half and can be set like `ggda:"sometimes=0.3"`;
+// customs and defaults are applied before this check and always win
+func (g *Generator[T]) skipSometimes(fieldType reflect.StructField, path string, index int) (bool, error) {
+	opts := cachedTag(fieldType)
+	raw, ok := opts["sometimes"]
+	if !ok {
+		return false, nil
+	}
+	p := defaultSometimesRate
+	if raw != "" {
+		var err error
+		if p, _, err = opts.floatOption(path, "sometimes"); err != nil {
+			return false, err
+		}
+		if p < 0 || p > 1 {
+			return false, fmt.Errorf("ggda: field %s: sometimes %g is outside [0, 1]", path, p)
+		}
+	}
+	return !g.boolWithRate(index, p), nil
+}
+
+// rateBool returns the value for a bool field honoring SetBoolRate and the truerate option
+func (g *Generator[T]) rateBool(opts tagOptions, path string, index int) (bool, error) {
+	if p, ok := g.boolRates[path]; ok {
+		return g.boolWithRate(index, p), nil
+	}
+	p, ok, err := opts.floatOption(path, "truerate")
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return g.boolValue(index), nil
+	}
+	if p < 0 || p > 1 {
+		return false, fmt.Errorf("ggda: field %s: truerate %g is outside [0, 1]", path, p)
+	}
+	return g.boolWithRate(index, p), nil
+}
+
+// sizedString applies the len, minlen and maxlen options to a generated string
+// len wins over the other two; shorter strings are padded and longer ones truncated
+// Lengths count runes, as validator does, so multibyte text is never cut mid-rune
+func sizedString(opts tagOptions, fieldName string, s string) (string, error) {
+	n, ok, err := opts.intOption(fieldName, "len")
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		if n < 0 {
+			return "", fmt.Errorf("ggda: field %s: negative len %d", fieldName, n)
+		}
+		return fitLength(s, int(n)), nil
+	}
+	lo, hasMin, err := opts.intOption(fieldName, "minlen")
+	if err != nil {
+		return "", err
+	}
+	hi, hasMax, err := opts.intOption(fieldName, "maxlen")
+	if err != nil {
+		return "", err
+	}
+	if (hasMin && lo < 0) || (hasMax && hi < 0) {
+		return "", fmt.Errorf("ggda: field %s: negative minlen or maxlen", fieldName)
+	}
+	if hasMin && hasMax && lo > hi {
+		return "", fmt.Errorf("ggda: field %s: minlen %d is greater than maxlen %d", fieldName, lo, hi)
+	}
+	if n := utf8.RuneCountInString(s); hasMin && n < int(lo) {
+		return fitLength(s, int(lo)), nil
+	}
+	if n := utf8.RuneCountInString(s); hasMax && n > int(hi) {
+		return fitLength(s, int(hi)), nil
+	}
+	return s, nil
+}
+
+// fitLength pads or truncates s to exactly n runes
+// Truncation keeps the end of s so the index suffix stays visible
+func fitLength(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) >= n {
+		return string(runes[len(runes)-n:])
+	}
+	return s + strings.Repeat(stringPadding, n-len(runes))
+}
+
+// pickOneOf sets field to one of the values in the oneof option
+// Values cycle by index, or are drawn from the seed when one is set
+// Weighted values like `ggda:"oneof=active:70,banned:30"` are picked in proportion to their weights
+// Containers, pointers and sql.Null* wrappers are skipped so the option applies to their values
+func (g *Generator[T]) pickOneOf(field reflect.Value, opts tagOptions, fieldName string, index int) (bool, error) {
+	values, ok := opts.listOption("oneof")
+	if !ok || isContainer(field.Kind()) || isSQLNull(field.Type()) {
+		return false, nil
+	}
+	values, weights, total := splitWeights(values)
+	if weights == nil {
+		i := index % len(values)
+		if g.rand != nil {
+			i = g.rand.Intn(len(values))
+		}
+		return true, setFromString(field, fieldName, values[i])
+	}
+	if total <= 0 {
+		return true, fmt.Errorf("ggda: field %s: oneof weights must add up to more than 0", fieldName)
+	}
+
+	// spread picks evenly with the golden ratio so proportions hold for any run of indexes
+	p := math.Mod(float64(index)*goldenRatio, 1) * total
+	if g.rand != nil {
+		p = g.rand.Float64() * total
+	}
+	for i, w := range weights {
+		if p < w {
+			return true, setFromString(field, fieldName, values[i])
+		}
+		p -= w
+	}
+	return true, setFromString(field, fieldName, values[len(values)-1])
+}
+
+// splitWeights separates values like "active:70" into values and weights
+// Values without a numeric weight count as 1; weights is nil when no value has one
+func splitWeights(raw []string) (values []string, weights []float64, total float64) {
+	values = make([]string, len(raw))
+	weighted := make([]float64, len(raw))
+	hasWeight := false
+	for i, v := range raw {
+		values[i], weighted[i] = v, 1
+		if j := strings.LastIndex(v, ":"); j >= 0 {
+			if w, err := strconv.ParseFloat(v[j+1:], 64); err == nil && w >= 0 {
+				values[i], weighted[i] = v[:j], w
+				hasWeight = true
+			}
+		}
+		total += weighted[i]
+	}
+	if !hasWeight {
+		return values, nil, total
+	}
+	return values, weighted, total
+}
+
+// setFromString parses s according to the kind of field and sets it
+// Types implementing encoding.TextUnmarshaler parse s themselves
+func setFromString(field reflect.Value, fieldName string, s string) error {
+	if u, ok := textUnmarshaler(field); ok {
+		if err := u.UnmarshalText([]byte(s)); err != nil {
+			return fmt.Errorf("ggda: field %s: invalid %s value %q: %w", fieldName, field.Type(), s, err)
+		}
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("ggda: field %s: invalid int value %q", fieldName, s)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("ggda: field %s: invalid uint value %q", fieldName, s)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("ggda: field %s: invalid float value %q", fieldName, s)
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("ggda: field %s: invalid bool value %q", fieldName, s)
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("ggda: field %s: cannot set %s from a string", fieldName, field.Type())
+	}
+	return nil
+}
+
+// isContainer reports whether values of kind k hold other values that are filled separately
+func isContainer(k reflect.Kind) bool {
+	switch k {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package ggda
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRangeWithinFieldSize(t *testing.T) {
+	type item struct {
+		A int8 `ggda:"range=-100,100"`
+	}
+	for i, v := range New[item]().Generate(300) {
+		if v.A < -100 || v.A > 100 {
+			t.Fatalf("item %d: A = %d; want within [-100, 100]", i, v.A)
+		}
+	}
+}
+
+func TestRangeOverflowingField(t *testing.T) {
+	type signed struct {
+		A int8 `ggda:"range=-200,200"`
+	}
+	type unsigned struct {
+		A uint8 `ggda:"max=300"`
+	}
+	if _, err := New[signed]().GenerateE(1); err == nil {
+		t.Error("range=-200,200 on int8: want an error")
+	}
+	if _, err := New[unsigned]().GenerateE(1); err == nil {
+		t.Error("max=300 on uint8: want an error")
+	}
+}
+
+func TestLengthCountsRunes(t *testing.T) {
+	type item struct {
+		Name  string `ggda:"name,len=4"`
+		Short string `ggda:"name,maxlen=2"`
+		Long  string `ggda:"name,minlen=8"`
+		Valid string `ggda:"name" validate:"min=6"`
+	}
+	g := New[item]().SetLocale("ja").RespectValidateTags(true)
+	for i, v := range g.Generate(5) {
+		for _, c := range []struct {
+			field, value string
+			ok           func(n int) bool
+		}{
+			{"Name", v.Name, func(n int) bool { return n == 4 }},
+			{"Short", v.Short, func(n int) bool { return n <= 2 }},
+			{"Long", v.Long, func(n int) bool { return n >= 8 }},
+			{"Valid", v.Valid, func(n int) bool { return n >= 6 }},
+		} {
+			if !utf8.ValidString(c.value) {
+				t.Errorf("item %d: %s = %q is not valid UTF-8", i, c.field, c.value)
+			}
+			if n := utf8.RuneCountInString(c.value); !c.ok(n) {
+				t.Errorf("item %d: %s = %q has %d runes", i, c.field, c.value, n)
+			}
+		}
+	}
+}
+
+type dictionaryItem struct {
+	Status string `ggda:"oneof=open closed"`
+	Kind   string `validate:"oneof=a b"`
+}
+
+func TestDictionaryWinsOverOneOf(t *testing.T) {
+	g := New[dictionaryItem]().RespectValidateTags(true)
+	g.SetDictionary("Status", []string{"pending"})
+	g.SetDictionary("Kind", []string{"z"})
+
+	for _, item := range g.Generate(3) {
+		if item.Status != "pending" || item.Kind != "z" {
+			t.Errorf("got %+v; want dictionary values", item)
+		}
+	}
+}
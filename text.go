@@ -0,0 +1,36 @@
+package ggda
+
+import (
+	"encoding"
+	"reflect"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// textUnmarshaler returns the encoding.TextUnmarshaler of field, if it implements one
+// Times, addresses and UUIDs have their own handling and are left out
+func textUnmarshaler(field reflect.Value) (encoding.TextUnmarshaler, bool) {
+	if !field.CanAddr() || field.Type() == timeType || !reflect.PointerTo(field.Type()).Implements(textUnmarshalerType) {
+		return nil, false
+	}
+	return field.Addr().Interface().(encoding.TextUnmarshaler), true
+}
+
+// fillText feeds a generated string, shaped by the usual string tags, to UnmarshalText
+// It reports false when field is not a TextUnmarshaler or rejects the string,
+// in which case the field is filled by its kind instead
+func (g *Generator[T]) fillText(field reflect.Value, opts tagOptions, fieldType reflect.StructField, path string, index int) (bool, error) {
+	u, ok := textUnmarshaler(field)
+	if !ok {
+		return false, nil
+	}
+	s, err := g.stringValue(opts, fieldType, path, index)
+	if err != nil {
+		return false, err
+	}
+	if err := u.UnmarshalText([]byte(s)); err != nil {
+		field.Set(reflect.Zero(field.Type()))
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,177 @@
+package ggda
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// defaultDurationStep is how much generated durations grow per index
+const defaultDurationStep = time.Second
+
+// durationValue returns the value for a time.Duration field
+// Without a tag durations grow by a second per index, or are random up to an hour with a seed
+// The dur option limits values to a range like `ggda:"dur=1s-1h"`
+func (g *Generator[T]) durationValue(opts tagOptions, fieldName string, index int) (time.Duration, error) {
+	raw, ok := opts["dur"]
+	if !ok {
+		if g.rand != nil {
+			return time.Duration(g.rand.Int63n(int64(time.Hour))) + 1, nil
+		}
+		return time.Duration(index+1) * defaultDurationStep, nil
+	}
+	lo, hi, err := parseDurationRange(raw)
+	if err != nil {
+		return 0, fmt.Errorf("ggda: field %s: invalid dur tag value %q: %w", fieldName, raw, err)
+	}
+	span := int64(hi - lo + 1)
+	if g.rand != nil {
+		return lo + time.Duration(g.rand.Int63n(span)), nil
+	}
+	return lo + time.Duration(int64(time.Duration(index)*defaultDurationStep)%span), nil
+}
+
+// parseDurationRange parses a range like "1s-1h"
+func parseDurationRange(raw string) (time.Duration, time.Duration, error) {
+	// skip a leading sign so negative lower bounds parse
+	sep := strings.Index(raw[min(1, len(raw)):], "-") + 1
+	if sep <= 0 {
+		return 0, 0, fmt.Errorf("expected <min>-<max>")
+	}
+	lo, err := time.ParseDuration(raw[:sep])
+	if err != nil {
+		return 0, 0, err
+	}
+	hi, err := time.ParseDuration(raw[sep+1:])
+	if err != nil {
+		return 0, 0, err
+	}
+	if lo > hi {
+		return 0, 0, fmt.Errorf("min %s is greater than max %s", lo, hi)
+	}
+	return lo, hi, nil
+}
+
+// SetTimeStrategy sets how time.Time fields are generated
+// Without a strategy time.Time fields are set to time.Now()
+func (g *Generator[T]) SetTimeStrategy(fn func(index int) time.Time) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.timeStrategy = fn
+	return g
+}
+
+// SetTimeLocation converts every generated time.Time to loc, e.g. time.UTC
+// It applies on top of the time strategy; nil keeps the times as they are
+func (g *Generator[T]) SetTimeLocation(loc *time.Location) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.timeLocation = loc
+	return g
+}
+
+// timeValue returns the value for a time.Time field
+func (g *Generator[T]) timeValue(index int) time.Time {
+	t := time.Now()
+	if fn := g.timeStrategy; fn != nil {
+		g.unlocked(func() { t = fn(index) })
+	}
+	if g.timeLocation != nil {
+		t = t.In(g.timeLocation)
+	}
+	return t
+}
+
+// FixedTime returns a time strategy that always yields t
+func FixedTime(t time.Time) func(index int) time.Time {
+	return func(int) time.Time {
+		return t
+	}
+}
+
+// IncrementingTime returns a time strategy that yields base plus step per index
+func IncrementingTime(base time.Time, step time.Duration) func(index int) time.Time {
+	return func(index int) time.Time {
+		return base.Add(time.Duration(index) * step)
+	}
+}
+
+// RandomTime returns a time strategy that yields times in [from, to)
+// The time for an index depends only on the seed and the index
+func RandomTime(from, to time.Time, seed int64) func(index int) time.Time {
+	span := to.Sub(from)
+	return func(index int) time.Time {
+		if span <= 0 {
+			return from
+		}
+		return from.Add(time.Duration(mix64(uint64(seed)+uint64(index)) % uint64(span)))
+	}
+}
+
+// timeOrder keeps one time field within maxGap after another
+type timeOrder struct {
+	earlier, later string
+	maxGap         time.Duration
+}
+
+// SetTimeOrder keeps laterField within [earlierField, earlierField+maxGap], e.g. for CreatedAt and UpdatedAt
+// laterField is overwritten after filling, before dependents run; the gap is derived from the index,
+// or is drawn from the seed when one is set. Both fields are time.Time or *time.Time
+func (g *Generator[T]) SetTimeOrder(earlierField, laterField string, maxGap time.Duration) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.timeOrders = append(g.timeOrders, timeOrder{earlier: earlierField, later: laterField, maxGap: max(maxGap, 0)})
+	return g
+}
+
+// applyTimeOrders sets the later field of each time order from its earlier field
+// Orders whose earlier field is a nil pointer are left as they are
+func (g *Generator[T]) applyTimeOrders(v reflect.Value, index int) error {
+	for _, o := range g.timeOrders {
+		earlier, err := fieldByPath(v, o.earlier)
+		if err != nil {
+			return err
+		}
+		later, err := fieldByPath(v, o.later)
+		if err != nil {
+			return err
+		}
+		if earlier.Kind() == reflect.Ptr {
+			if earlier.IsNil() {
+				continue
+			}
+			earlier = earlier.Elem()
+		}
+		if earlier.Type() != timeType {
+			return fmt.Errorf("ggda: field %s: time order needs time.Time, got %s", o.earlier, earlier.Type())
+		}
+		gap := int64(mix64(uint64(index)) % (uint64(o.maxGap) + 1))
+		if g.rand != nil {
+			gap = g.rand.Int63n(int64(o.maxGap) + 1)
+		}
+		t := earlier.Interface().(time.Time).Add(time.Duration(gap))
+		if err := setTime(later, o.later, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setTime sets a time.Time or *time.Time field to t
+func setTime(field reflect.Value, path string, t time.Time) error {
+	switch field.Type() {
+	case timeType:
+		field.Set(reflect.ValueOf(t))
+	case reflect.PointerTo(timeType):
+		field.Set(reflect.ValueOf(&t))
+	default:
+		return fmt.Errorf("ggda: field %s: time order needs time.Time, got %s", path, field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package ggda
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+)
+
+// RandomUUIDs switches UUID fields from deterministic to random version 4 UUIDs
+// Deterministic UUIDs depend only on the seed, the field and the index, so the same index always yields the same UUID
+// UUID fields are [16]byte arrays named UUID, like google/uuid's, and fields tagged `ggda:"uuid"`
+func (g *Generator[T]) RandomUUIDs(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.randomUUIDs = enabled
+	return g
+}
+
+// isUUID reports whether a field of type t holds a UUID
+func isUUID(t reflect.Type, opts tagOptions) bool {
+	if t.Kind() != reflect.Array || t.Len() != 16 || t.Elem().Kind() != reflect.Uint8 {
+		return false
+	}
+	_, tagged := opts["uuid"]
+	return tagged || t.Name() == "UUID"
+}
+
+// uuidValue returns a version 4 UUID for the field at path and the index
+func (g *Generator[T]) uuidValue(path string, index int) [16]byte {
+	var u [16]byte
+	if g.randomUUIDs {
+		rand.Read(u[:])
+	} else {
+		f := fnv.New64a()
+		f.Write([]byte(path))
+		h := mix64(uint64(g.seed) ^ f.Sum64() ^ mix64(uint64(index)))
+		binary.BigEndian.PutUint64(u[:8], h)
+		binary.BigEndian.PutUint64(u[8:], mix64(h))
+	}
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// formatUUID formats u in the canonical 8-4-4-4-12 form
+func formatUUID(u [16]byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
+
+// fillUUID sets a UUID array field
+func (g *Generator[T]) fillUUID(field reflect.Value, path string, index int) {
+	reflect.Copy(field, reflect.ValueOf(g.uuidValue(path, index)))
+}
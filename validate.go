@@ -0,0 +1,127 @@
+package ggda
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+)
+
+// validateTagName is the struct tag key used by go-playground/validator
+const validateTagName = "validate"
+
+// RespectValidateTags makes generation follow common `validate` tag rules
+// min, max, gte, lte, len, oneof, email and url are understood; min, max and len limit
+// the length of strings, the value of numbers and the number of slice and map entries,
+// including lengths set with SetSliceLen; rules after dive apply to the elements;
+// ggda tags win when both set an option
+func (g *Generator[T]) RespectValidateTags(enabled bool) *Generator[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.respectValidate = enabled
+	return g
+}
+
+// withValidateRules adds the options implied by the validate tag of f to opts
+// On slices, arrays and maps the rules before dive limit the number of entries
+// and the rules after it apply to the elements
+func withValidateRules(opts tagOptions, f reflect.StructField) tagOptions {
+	tag := f.Tag.Get(validateTagName)
+	if tag == "" {
+		return opts
+	}
+	t := f.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	container := t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map
+	kind := elemType(f.Type).Kind()
+	dived := false
+	rules := tagOptions{}
+	for _, rule := range strings.Split(tag, ",") {
+		// alternatives, nested dives and map key rules are beyond what generation can honor
+		if strings.Contains(rule, "|") || rule == "keys" {
+			break
+		}
+		if rule == "dive" {
+			if !container || dived {
+				break
+			}
+			dived = true
+			continue
+		}
+		key, value, _ := strings.Cut(rule, "=")
+		if container && !dived {
+			rules.setItemLimit(key, value)
+			continue
+		}
+		switch key {
+		case "min", "gte":
+			rules.setLimit(kind, "min", "minlen", value)
+		case "max", "lte":
+			rules.setLimit(kind, "max", "maxlen", value)
+		case "len":
+			if kind == reflect.String {
+				rules["len"] = value
+			} else {
+				rules.setLimit(kind, "min", "", value)
+				rules.setLimit(kind, "max", "", value)
+			}
+		case "oneof":
+			rules["oneof"] = strings.Join(strings.Fields(value), ",")
+		case "email", "url":
+			rules[key] = ""
+		}
+	}
+	if len(rules) == 0 {
+		return opts
+	}
+	merged := maps.Clone(rules)
+	maps.Copy(merged, opts)
+	return merged
+}
+
+// setItemLimit stores a validate bound on a container as a limit on its number of entries
+func (o tagOptions) setItemLimit(key, value string) {
+	switch key {
+	case "min", "gte":
+		o["minitems"] = value
+	case "max", "lte":
+		o["maxitems"] = value
+	case "len":
+		o["minitems"] = value
+		o["maxitems"] = value
+	}
+}
+
+// itemCount limits n, the number of entries of a slice or map, to the minitems and maxitems options
+func (o tagOptions) itemCount(fieldName string, n int) (int, error) {
+	lo, hasMin, err := o.intOption(fieldName, "minitems")
+	if err != nil {
+		return 0, err
+	}
+	hi, hasMax, err := o.intOption(fieldName, "maxitems")
+	if err != nil {
+		return 0, err
+	}
+	if hasMin && int64(n) < lo {
+		n = int(lo)
+	}
+	if hasMax && int64(n) > hi {
+		n = int(hi)
+	}
+	return max(n, 0), nil
+}
+
+// setLimit stores a validate bound as a length option for strings and a value option for numbers
+func (o tagOptions) setLimit(kind reflect.Kind, valueKey, lenKey, value string) {
+	switch kind {
+	case reflect.String:
+		if lenKey != "" {
+			o[lenKey] = value
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		o[valueKey] = value
+	}
+}
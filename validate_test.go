@@ -0,0 +1,36 @@
+package ggda
+
+import (
+	"testing"
+)
+
+func TestValidateRulesOnContainers(t *testing.T) {
+	type item struct {
+		Nums  []int          `validate:"max=2"`
+		Tags  []string       `validate:"min=2,max=3,dive,min=5"`
+		Codes []int          `validate:"dive,min=10,max=20"`
+		Attrs map[string]int `validate:"len=2"`
+	}
+	g := New[item]().RespectValidateTags(true).SetSliceLen("Nums", 3)
+	for i, v := range g.Generate(20) {
+		if len(v.Nums) > 2 {
+			t.Errorf("item %d: len(Nums) = %d; want at most 2", i, len(v.Nums))
+		}
+		if len(v.Tags) < 2 || len(v.Tags) > 3 {
+			t.Errorf("item %d: len(Tags) = %d; want 2 to 3", i, len(v.Tags))
+		}
+		for _, tag := range v.Tags {
+			if len(tag) < 5 {
+				t.Errorf("item %d: tag %q is shorter than 5", i, tag)
+			}
+		}
+		for _, c := range v.Codes {
+			if c < 10 || c > 20 {
+				t.Errorf("item %d: code %d; want within [10, 20]", i, c)
+			}
+		}
+		if len(v.Attrs) != 2 {
+			t.Errorf("item %d: len(Attrs) = %d; want 2", i, len(v.Attrs))
+		}
+	}
+}